@@ -17,7 +17,7 @@ func main() {
 	args := os.Args[1:]
 
 	cmd := exec.Command("tern", args...)
-	cmd.Env = append(os.Environ())
+	cmd.Env = os.Environ()
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"nlw-journey/internal/api"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/token"
+	"strings"
+)
+
+// authenticate puts the identity of requests carrying a valid `Authorization: Bearer <session>` header on their
+// context. Requests without the header go through anonymously, leaving it up to each handler to require an identity.
+func authenticate(signer token.Signer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			if header == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			session, found := strings.CutPrefix(header, "Bearer ")
+			if !found {
+				unauthorized(w, "Cabeçalho de autenticação inválido.")
+				return
+			}
+
+			claims, err := signer.Verify(session, token.PurposeSession)
+			if err != nil {
+				unauthorized(w, "Sessão inválida ou expirada.")
+				return
+			}
+
+			ctx := api.WithIdentity(r.Context(), api.Identity{Email: claims.Subject})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func unauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	_ = json.NewEncoder(w).Encode(spec.Error{Message: message})
+}
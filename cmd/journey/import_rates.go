@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"nlw-journey/internal/currency"
+	"nlw-journey/internal/pgstore"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// importExchangeRates loads the exchange rates in the file given as argument, replacing the rates already loaded for
+// the same currencies and dates. The file's extension tells whether it is CSV or JSON.
+func importExchangeRates(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: journey import-rates <rates.csv|rates.json>")
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	rates, err := currency.ReadRates(file, strings.TrimPrefix(filepath.Ext(args[0]), "."))
+	if err != nil {
+		return err
+	}
+
+	params := make([]pgstore.UpsertExchangeRateParams, len(rates))
+	for i, rate := range rates {
+		var value pgtype.Numeric
+		if err := value.Scan(rate.Rate); err != nil {
+			return fmt.Errorf("rate %d: invalid rate %q: %w", i+1, rate.Rate, err)
+		}
+
+		params[i] = pgstore.UpsertExchangeRateParams{
+			BaseCurrency:  rate.Base,
+			QuoteCurrency: rate.Quote,
+			EffectiveOn:   pgtype.Date{Time: rate.EffectiveOn, Valid: true},
+			Rate:          value,
+		}
+	}
+
+	pool, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	if err := pgstore.New(pool).ImportExchangeRates(ctx, pool, params); err != nil {
+		return err
+	}
+
+	fmt.Printf("Imported %d exchange rates.\n", len(params))
+	return nil
+}
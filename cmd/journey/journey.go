@@ -15,8 +15,11 @@ import (
 	"nlw-journey/internal/api"
 	"nlw-journey/internal/api/spec"
 	"nlw-journey/internal/mail/mailpit"
+	"nlw-journey/internal/token"
+	"nlw-journey/internal/unfurl"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 )
@@ -32,6 +35,26 @@ func main() {
 		os.Exit(1)
 	}
 
+	// journey import-rates <file> loads exchange rates and journey normalize-links rewrites the saved links' urls
+	// instead of starting the server
+	if len(os.Args) > 1 {
+		var task func(context.Context, []string) error
+		switch os.Args[1] {
+		case "import-rates":
+			task = importExchangeRates
+		case "normalize-links":
+			task = normalizeLinks
+		}
+
+		if task != nil {
+			if err := task(ctx, os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	if err := run(ctx); err != nil {
 		fmt.Println("Server forcedly closed because of an error:")
 		fmt.Fprintln(os.Stderr, err.Error())
@@ -41,6 +64,28 @@ func main() {
 	fmt.Println("Shutting down the application.")
 }
 
+func connect(ctx context.Context) (*pgxpool.Pool, error) {
+	pool, err := pgxpool.New(ctx, fmt.Sprintf(
+		"host=%s port=%s user=%s dbname=%s password=%s",
+		os.Getenv("JOURNEY_DATABASE_HOST"),
+		os.Getenv("JOURNEY_DATABASE_PORT"),
+		os.Getenv("JOURNEY_DATABASE_USER"),
+		os.Getenv("JOURNEY_DATABASE_NAME"),
+		os.Getenv("JOURNEY_DATABASE_PASSWORD"),
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return pool, nil
+}
+
 func run(ctx context.Context) error {
 	cfg := zap.NewDevelopmentConfig()
 	cfg.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
@@ -55,29 +100,29 @@ func run(ctx context.Context) error {
 		_ = logger.Sync()
 	}()
 
-	pool, err := pgxpool.New(ctx, fmt.Sprintf(
-		"host=%s port=%s user=%s dbname=%s password=%s",
-		os.Getenv("JOURNEY_DATABASE_HOST"),
-		os.Getenv("JOURNEY_DATABASE_PORT"),
-		os.Getenv("JOURNEY_DATABASE_USER"),
-		os.Getenv("JOURNEY_DATABASE_NAME"),
-		os.Getenv("JOURNEY_DATABASE_PASSWORD"),
-	))
-
+	pool, err := connect(ctx)
 	if err != nil {
 		return err
 	}
 
-	if err := pool.Ping(ctx); err != nil {
-		return err
+	tokenSecret := os.Getenv("JOURNEY_TOKEN_SECRET")
+	if tokenSecret == "" {
+		return errors.New("JOURNEY_TOKEN_SECRET must be set")
 	}
+	signer := token.NewSigner([]byte(tokenSecret))
+
+	// an unset or invalid value keeps the legacy confirmation routes disabled
+	legacyConfirmation, _ := strconv.ParseBool(os.Getenv("JOURNEY_LEGACY_CONFIRMATION_ROUTES"))
 
-	var mailer = mailpit.NewMailPit(pool, logger)
+	var mailer = mailpit.NewMailPit(pool, logger, signer)
+	go newMailWorker(pool, mailer, logger).Run(ctx)
+	go newTripCompleter(pool, logger).Run(ctx)
+	go newLinkUnfurler(pool, unfurl.NewHTTPFetcher(unfurl.Config{}), logger).Run(ctx)
 
-	si := api.NewAPI(pool, logger, mailer)
+	si := api.NewAPI(pool, logger, signer, legacyConfirmation)
 
 	router := chi.NewRouter()
-	router.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger))
+	router.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger), authenticate(signer))
 	router.Mount("/", spec.Handler(si))
 
 	server := &http.Server{
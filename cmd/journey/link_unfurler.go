@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+	"nlw-journey/internal/pgstore"
+	"nlw-journey/internal/unfurl"
+	"time"
+)
+
+const (
+	linkUnfurlerPollInterval = 5 * time.Second
+	linkUnfurlerBatchSize    = 10
+)
+
+// linkUnfurler fills in the previews of new links, and of links whose url changed, in the background.
+type linkUnfurler struct {
+	queries *pgstore.Queries
+	fetcher unfurl.Fetcher
+	logger  *zap.Logger
+}
+
+func newLinkUnfurler(pool *pgxpool.Pool, fetcher unfurl.Fetcher, logger *zap.Logger) linkUnfurler {
+	return linkUnfurler{
+		queries: pgstore.New(pool),
+		fetcher: fetcher,
+		logger:  logger.Named("link_unfurler"),
+	}
+}
+
+// Run polls for links without a preview until ctx is cancelled. Links are claimed with SKIP LOCKED, so several
+// instances of the API never fetch the same link at once.
+func (unfurler linkUnfurler) Run(ctx context.Context) {
+	ticker := time.NewTicker(linkUnfurlerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		unfurler.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (unfurler linkUnfurler) poll(ctx context.Context) {
+	links, err := unfurler.queries.ClaimLinksToUnfurl(ctx, linkUnfurlerBatchSize)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			unfurler.logger.Error("failed to claim links to unfurl", zap.Error(err))
+		}
+		return
+	}
+
+	for _, link := range links {
+		unfurler.unfurl(ctx, link)
+	}
+}
+
+// unfurl fetches the link's preview and saves it. Failures are saved as an empty preview rather than retried, since
+// pages that can't be read now, or that point to private addresses, are unlikely to change.
+func (unfurler linkUnfurler) unfurl(ctx context.Context, link pgstore.ClaimLinksToUnfurlRow) {
+	preview, err := unfurler.fetcher.Fetch(ctx, link.Url)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		unfurler.logger.Warn("failed to unfurl link", zap.Error(err), zap.String("linkID", link.ID.String()))
+	}
+
+	if err := unfurler.queries.SaveLinkPreview(ctx, pgstore.SaveLinkPreviewParams{
+		PreviewTitle:       optionalText(preview.Title),
+		PreviewDescription: optionalText(preview.Description),
+		PreviewImageUrl:    optionalText(preview.ImageURL),
+		FaviconUrl:         optionalText(preview.FaviconURL),
+		ID:                 link.ID,
+		Url:                link.Url,
+	}); err != nil {
+		unfurler.logger.Error("failed to save link preview", zap.Error(err), zap.String("linkID", link.ID.String()))
+	}
+}
+
+func optionalText(value string) pgtype.Text {
+	return pgtype.Text{String: value, Valid: value != ""}
+}
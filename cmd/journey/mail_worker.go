@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+	"nlw-journey/internal/pgstore"
+	"time"
+)
+
+const (
+	mailWorkerPollInterval = 5 * time.Second
+	mailWorkerBatchSize    = 10
+	mailMaxAttempts        = 5
+	mailBaseBackoff        = 30 * time.Second
+	mailMaxBackoff         = time.Hour
+)
+
+type Mailer interface {
+	SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error
+	SendConfirmedTripNotificationEmail(trip pgstore.Trip) error
+	SendTripInvitationEmail(trip pgstore.Trip, participant pgstore.Participant) error
+	SendMagicLinkEmail(email string) error
+	SendParticipantRemovedEmail(trip pgstore.Trip, email string) error
+	SendTripCancelledEmail(trip pgstore.Trip, participant pgstore.Participant) error
+}
+
+// errPermanent marks failures that won't be fixed by retrying, such as an unknown mail kind.
+var errPermanent = errors.New("permanent mail failure")
+
+type mailWorker struct {
+	queries *pgstore.Queries
+	mailer  Mailer
+	logger  *zap.Logger
+}
+
+func newMailWorker(pool *pgxpool.Pool, mailer Mailer, logger *zap.Logger) mailWorker {
+	return mailWorker{
+		queries: pgstore.New(pool),
+		mailer:  mailer,
+		logger:  logger.Named("mail_worker"),
+	}
+}
+
+// Run polls the mail queue until ctx is cancelled. Jobs are claimed with SKIP LOCKED, so running several workers
+// (or several instances of the API) never delivers the same e-mail twice.
+func (worker mailWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(mailWorkerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		worker.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (worker mailWorker) poll(ctx context.Context) {
+	jobs, err := worker.queries.ClaimMailJobs(ctx, mailWorkerBatchSize)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			worker.logger.Error("failed to claim mail jobs", zap.Error(err))
+		}
+		return
+	}
+
+	for _, job := range jobs {
+		worker.handle(ctx, job)
+	}
+}
+
+func (worker mailWorker) handle(ctx context.Context, job pgstore.MailJob) {
+	err := worker.deliver(ctx, job)
+	if err == nil {
+		if err := worker.queries.MarkMailJobAsSent(ctx, job.ID); err != nil {
+			worker.logger.Error("failed to mark mail job as sent", zap.Error(err), zap.String("jobID", job.ID.String()))
+		}
+		return
+	}
+
+	lastError := pgtype.Text{String: err.Error(), Valid: true}
+
+	// a missing row means the trip or participant was removed after the e-mail was queued, so retrying is pointless
+	if errors.Is(err, errPermanent) || errors.Is(err, pgx.ErrNoRows) || job.Attempts >= mailMaxAttempts {
+		worker.logger.Error("mail job is dead", zap.Error(err), zap.String("jobID", job.ID.String()), zap.Int32("attempts", job.Attempts))
+
+		if err := worker.queries.MarkMailJobAsDead(ctx, pgstore.MarkMailJobAsDeadParams{
+			LastError: lastError,
+			ID:        job.ID,
+		}); err != nil {
+			worker.logger.Error("failed to mark mail job as dead", zap.Error(err), zap.String("jobID", job.ID.String()))
+		}
+		return
+	}
+
+	// the next run is computed from the database clock, which is also the one ClaimMailJobs compares it to
+	backoff := mailBackoff(job.Attempts)
+	worker.logger.Warn("mail job failed, retrying later", zap.Error(err), zap.String("jobID", job.ID.String()), zap.Duration("backoff", backoff))
+
+	if err := worker.queries.RetryMailJob(ctx, pgstore.RetryMailJobParams{
+		LastError: lastError,
+		Backoff:   pgtype.Interval{Microseconds: backoff.Microseconds(), Valid: true},
+		ID:        job.ID,
+	}); err != nil {
+		worker.logger.Error("failed to reschedule mail job", zap.Error(err), zap.String("jobID", job.ID.String()))
+	}
+}
+
+func (worker mailWorker) deliver(ctx context.Context, job pgstore.MailJob) error {
+	var payload pgstore.MailPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return fmt.Errorf("%w: invalid payload: %w", errPermanent, err)
+	}
+
+	switch job.Kind {
+	case pgstore.MailKindConfirmTrip:
+		return worker.mailer.SendConfirmTripEmailToTripOwner(payload.TripID)
+
+	case pgstore.MailKindTripConfirmed:
+		trip, err := worker.queries.GetTrip(ctx, payload.TripID)
+		if err != nil {
+			return err
+		}
+		return worker.mailer.SendConfirmedTripNotificationEmail(trip)
+
+	case pgstore.MailKindTripInvitation, pgstore.MailKindTripCancelled:
+		trip, err := worker.queries.GetTrip(ctx, payload.TripID)
+		if err != nil {
+			return err
+		}
+
+		participant, err := worker.queries.GetParticipant(ctx, payload.ParticipantID)
+		if err != nil {
+			return err
+		}
+
+		if job.Kind == pgstore.MailKindTripCancelled {
+			return worker.mailer.SendTripCancelledEmail(trip, participant)
+		}
+		return worker.mailer.SendTripInvitationEmail(trip, participant)
+
+	case pgstore.MailKindMagicLink:
+		return worker.mailer.SendMagicLinkEmail(payload.Email)
+
+	case pgstore.MailKindParticipantRemoved:
+		trip, err := worker.queries.GetTrip(ctx, payload.TripID)
+		if err != nil {
+			return err
+		}
+		return worker.mailer.SendParticipantRemovedEmail(trip, payload.Email)
+
+	default:
+		return fmt.Errorf("%w: unknown mail kind %q", errPermanent, job.Kind)
+	}
+}
+
+// mailBackoff doubles the waiting time on every attempt, starting from mailBaseBackoff and capped at mailMaxBackoff.
+func mailBackoff(attempts int32) time.Duration {
+	backoff := mailBaseBackoff
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= mailMaxBackoff {
+			return mailMaxBackoff
+		}
+	}
+	return backoff
+}
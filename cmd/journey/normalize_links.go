@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlw-journey/internal/api"
+	"nlw-journey/internal/pgstore"
+)
+
+// normalizeLinks saves the url of every link the way new links are saved and removes the links that turn out to be
+// repeated in their trip. It must run before the migration that makes links unique per trip, and can be run again.
+func normalizeLinks(ctx context.Context, args []string) error {
+	if len(args) != 0 {
+		return errors.New("usage: journey normalize-links")
+	}
+
+	pool, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	result, err := pgstore.New(pool).NormalizeLinkURLs(ctx, pool, api.NormalizeLinkURL)
+	if err != nil {
+		return err
+	}
+
+	for _, link := range result.Skipped {
+		fmt.Printf("Kept link %s of trip %s as it is, its url can't be normalized: %s\n", link.ID, link.TripID, link.Url)
+	}
+
+	for _, link := range result.Removed {
+		fmt.Printf("Removed link %s of trip %s, repeated in the trip: %q %s\n", link.ID, link.TripID, link.Title, link.Url)
+	}
+
+	fmt.Printf("Normalized %d links and removed %d repeated links.\n", result.Updated, len(result.Removed))
+	return nil
+}
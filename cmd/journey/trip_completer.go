@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+	"nlw-journey/internal/pgstore"
+	"time"
+)
+
+const tripCompleterInterval = 15 * time.Minute
+
+// tripCompleter marks confirmed trips as completed once their ends_at has passed.
+type tripCompleter struct {
+	queries *pgstore.Queries
+	logger  *zap.Logger
+}
+
+func newTripCompleter(pool *pgxpool.Pool, logger *zap.Logger) tripCompleter {
+	return tripCompleter{
+		queries: pgstore.New(pool),
+		logger:  logger.Named("trip_completer"),
+	}
+}
+
+// Run completes ended trips right away and then every tripCompleterInterval, until ctx is cancelled.
+func (completer tripCompleter) Run(ctx context.Context) {
+	ticker := time.NewTicker(tripCompleterInterval)
+	defer ticker.Stop()
+
+	for {
+		completed, err := completer.queries.CompleteEndedTrips(ctx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			completer.logger.Error("failed to complete ended trips", zap.Error(err))
+		}
+		if completed > 0 {
+			completer.logger.Info("completed ended trips", zap.Int64("trips", completed))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+	"nlw-journey/internal/pgstore"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+const usage = `Usage:
+  mailqueue list             lists the e-mails that exhausted their delivery attempts
+  mailqueue requeue <id>...  puts the given dead e-mails back on the queue
+  mailqueue requeue --all    puts every dead e-mail back on the queue`
+
+func main() {
+	if err := godotenv.Load(); err != nil {
+		fmt.Println("Error loading .env file")
+	}
+
+	args := os.Args[1:]
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	pool, err := pgxpool.New(ctx, fmt.Sprintf(
+		"host=%s port=%s user=%s dbname=%s password=%s",
+		os.Getenv("JOURNEY_DATABASE_HOST"),
+		os.Getenv("JOURNEY_DATABASE_PORT"),
+		os.Getenv("JOURNEY_DATABASE_USER"),
+		os.Getenv("JOURNEY_DATABASE_NAME"),
+		os.Getenv("JOURNEY_DATABASE_PASSWORD"),
+	))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer pool.Close()
+
+	queries := pgstore.New(pool)
+
+	switch args[0] {
+	case "list":
+		err = list(ctx, queries)
+	case "requeue":
+		err = requeue(ctx, queries, args[1:])
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func list(ctx context.Context, queries *pgstore.Queries) error {
+	jobs, err := queries.GetDeadMailJobs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list dead mail jobs: %w", err)
+	}
+
+	if len(jobs) == 0 {
+		fmt.Println("There are no dead e-mails.")
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tKIND\tATTEMPTS\tCREATED AT\tLAST ERROR")
+	for _, job := range jobs {
+		fmt.Fprintf(writer, "%s\t%s\t%d\t%s\t%s\n",
+			job.ID,
+			job.Kind,
+			job.Attempts,
+			job.CreatedAt.Time.Format(time.DateTime),
+			job.LastError.String,
+		)
+	}
+
+	return writer.Flush()
+}
+
+func requeue(ctx context.Context, queries *pgstore.Queries, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("requeue expects at least one id or --all\n%s", usage)
+	}
+
+	var ids []uuid.UUID
+	if len(args) == 1 && args[0] == "--all" {
+		jobs, err := queries.GetDeadMailJobs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list dead mail jobs: %w", err)
+		}
+
+		for _, job := range jobs {
+			ids = append(ids, job.ID)
+		}
+	} else {
+		for _, arg := range args {
+			id, err := uuid.Parse(arg)
+			if err != nil {
+				return fmt.Errorf("invalid id %q: %w", arg, err)
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	for _, id := range ids {
+		affected, err := queries.RequeueMailJob(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to requeue %s: %w", id, err)
+		}
+
+		if affected == 0 {
+			fmt.Printf("%s is not a dead e-mail, skipping.\n", id)
+			continue
+		}
+
+		fmt.Printf("%s requeued.\n", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,567 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"strings"
+	"time"
+)
+
+// PostTripsTripIDProposals Propose an activity to the trip members.
+// (POST /trips/{tripId}/proposals)
+func (api API) PostTripsTripIDProposals(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PostTripsTripIDProposalsJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess)
+	if apiErr != nil {
+		return spec.PostTripsTripIDProposalsJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PostTripsTripIDProposalsJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDProposalsJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDProposalsJSON400Response(invalidInput(err))
+	}
+
+	var fields []spec.FieldError
+	if body.OccursAt != nil {
+		if apiErr := checkActivityDate(trip, *body.OccursAt); apiErr != nil {
+			fields = append(fields, apiErr.Errors...)
+		}
+	}
+
+	options := make([]pgstore.InsertActivityProposalOptionParams, len(body.Options))
+	for i, option := range body.Options {
+		options[i] = pgstore.InsertActivityProposalOptionParams{Label: option.Label}
+
+		if option.OccursAt != nil {
+			if apiErr := checkActivityDate(trip, *option.OccursAt); apiErr != nil {
+				fields = append(fields, spec.FieldError{
+					Field:   fmt.Sprintf("options[%d].occurs_at", i),
+					Message: apiErr.Errors[0].Message,
+				})
+			}
+			options[i].OccursAt = pgtype.Timestamptz{Time: *option.OccursAt, Valid: true}
+		}
+	}
+
+	if len(fields) > 0 {
+		return spec.PostTripsTripIDProposalsJSON400Response(spec.Error{Message: "Input inválido.", Errors: fields})
+	}
+
+	identity, _ := IdentityFromContext(r.Context())
+	proposal := pgstore.InsertActivityProposalParams{
+		TripID:     tripID,
+		Title:      body.Title,
+		ProposedBy: identity.Email,
+	}
+	if body.OccursAt != nil {
+		proposal.OccursAt = pgtype.Timestamptz{Time: *body.OccursAt, Valid: true}
+	}
+
+	proposalID, err := api.repository.CreateActivityProposal(r.Context(), api.pool, proposal, options)
+	if err != nil {
+		api.logger.Error("failed to create activity proposal", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.PostTripsTripIDProposalsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostTripsTripIDProposalsJSON201Response(spec.CreateActivityProposalResponse{ProposalID: proposalID.String()})
+}
+
+// DeleteTripsTripIDProposalsProposalID Delete an activity proposal.
+// (DELETE /trips/{tripId}/proposals/{proposalId})
+func (api API) DeleteTripsTripIDProposalsProposalID(_ http.ResponseWriter, r *http.Request, _tripID string, _proposalID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDProposalsProposalIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	proposalID, err := uuid.Parse(_proposalID)
+	if err != nil {
+		return spec.DeleteTripsTripIDProposalsProposalIDJSON400Response(spec.Error{Message: "ID de proposta inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess)
+	if apiErr != nil {
+		return spec.DeleteTripsTripIDProposalsProposalIDJSON400Response(*apiErr).Status(status)
+	}
+
+	proposal, apiErr := api.getActivityProposal(r.Context(), tripID, proposalID)
+	if apiErr != nil {
+		return spec.DeleteTripsTripIDProposalsProposalIDJSON400Response(*apiErr)
+	}
+
+	identity, _ := IdentityFromContext(r.Context())
+	if !strings.EqualFold(trip.OwnerEmail, identity.Email) && !strings.EqualFold(proposal.ProposedBy, identity.Email) {
+		return spec.DeleteTripsTripIDProposalsProposalIDJSON403Response(spec.Error{
+			Message: "Apenas o dono da viagem ou quem propôs a atividade pode removê-la.",
+		})
+	}
+
+	if _, err := api.repository.DeleteActivityProposal(r.Context(), pgstore.DeleteActivityProposalParams{ID: proposalID, TripID: tripID}); err != nil {
+		api.logger.Error("failed to delete activity proposal", zap.Error(err), zap.String("proposalID", _proposalID))
+		return spec.DeleteTripsTripIDProposalsProposalIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.DeleteTripsTripIDProposalsProposalIDJSON204Response(struct{}{})
+}
+
+// PutTripsTripIDProposalsProposalIDVote Vote on an activity proposal.
+// (PUT /trips/{tripId}/proposals/{proposalId}/vote)
+func (api API) PutTripsTripIDProposalsProposalIDVote(_ http.ResponseWriter, r *http.Request, _tripID string, _proposalID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PutTripsTripIDProposalsProposalIDVoteJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	proposalID, err := uuid.Parse(_proposalID)
+	if err != nil {
+		return spec.PutTripsTripIDProposalsProposalIDVoteJSON400Response(spec.Error{Message: "ID de proposta inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess)
+	if apiErr != nil {
+		return spec.PutTripsTripIDProposalsProposalIDVoteJSON400Response(*apiErr).Status(status)
+	}
+
+	identity, _ := IdentityFromContext(r.Context())
+	if status, apiErr := api.authorizeVoter(r.Context(), trip, identity.Email); apiErr != nil {
+		return spec.PutTripsTripIDProposalsProposalIDVoteJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PutTripsTripIDProposalsProposalIDVoteJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDProposalsProposalIDVoteJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDProposalsProposalIDVoteJSON400Response(invalidInput(err))
+	}
+
+	proposal, apiErr := api.getOpenActivityProposal(r.Context(), tripID, proposalID)
+	if apiErr != nil {
+		return spec.PutTripsTripIDProposalsProposalIDVoteJSON400Response(*apiErr)
+	}
+
+	options, err := api.repository.GetActivityProposalOptions(r.Context(), proposal.ID)
+	if err != nil {
+		api.logger.Error("failed to get activity proposal options", zap.Error(err), zap.String("proposalID", _proposalID))
+		return spec.PutTripsTripIDProposalsProposalIDVoteJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	vote := pgstore.UpsertActivityProposalVoteParams{ProposalID: proposal.ID, Email: identity.Email}
+
+	var fields []spec.FieldError
+	if len(options) > 0 {
+		if body.Vote != nil {
+			fields = append(fields, spec.FieldError{Field: "vote", Message: "Essa proposta é uma enquete, escolha uma das opções em option_id."})
+		}
+
+		if body.OptionID == nil {
+			fields = append(fields, spec.FieldError{Field: "option_id", Message: "Escolha uma das opções da enquete."})
+		} else if option, ok := findActivityProposalOption(options, *body.OptionID); ok {
+			vote.OptionID = pgtype.UUID{Bytes: option.ID, Valid: true}
+		} else {
+			fields = append(fields, spec.FieldError{Field: "option_id", Message: "Opção não encontrada nessa enquete."})
+		}
+	} else {
+		if body.OptionID != nil {
+			fields = append(fields, spec.FieldError{Field: "option_id", Message: "Essa proposta não é uma enquete, vote com up ou down em vote."})
+		}
+
+		switch {
+		case body.Vote == nil:
+			fields = append(fields, spec.FieldError{Field: "vote", Message: "Vote com up ou down."})
+		case *body.Vote == "up":
+			vote.Value = pgtype.Int2{Int16: 1, Valid: true}
+		default:
+			vote.Value = pgtype.Int2{Int16: -1, Valid: true}
+		}
+	}
+
+	if len(fields) > 0 {
+		return spec.PutTripsTripIDProposalsProposalIDVoteJSON400Response(spec.Error{Message: "Input inválido.", Errors: fields})
+	}
+
+	if err := api.repository.UpsertActivityProposalVote(r.Context(), vote); err != nil {
+		api.logger.Error("failed to save activity proposal vote", zap.Error(err), zap.String("proposalID", _proposalID))
+		return spec.PutTripsTripIDProposalsProposalIDVoteJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PutTripsTripIDProposalsProposalIDVoteJSON204Response(struct{}{})
+}
+
+// DeleteTripsTripIDProposalsProposalIDVote Withdraw a vote on an activity proposal.
+// (DELETE /trips/{tripId}/proposals/{proposalId}/vote)
+func (api API) DeleteTripsTripIDProposalsProposalIDVote(_ http.ResponseWriter, r *http.Request, _tripID string, _proposalID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDProposalsProposalIDVoteJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	proposalID, err := uuid.Parse(_proposalID)
+	if err != nil {
+		return spec.DeleteTripsTripIDProposalsProposalIDVoteJSON400Response(spec.Error{Message: "ID de proposta inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.DeleteTripsTripIDProposalsProposalIDVoteJSON400Response(*apiErr).Status(status)
+	}
+
+	if _, apiErr := api.getOpenActivityProposal(r.Context(), tripID, proposalID); apiErr != nil {
+		return spec.DeleteTripsTripIDProposalsProposalIDVoteJSON400Response(*apiErr)
+	}
+
+	identity, _ := IdentityFromContext(r.Context())
+	deleted, err := api.repository.DeleteActivityProposalVote(r.Context(), pgstore.DeleteActivityProposalVoteParams{
+		ProposalID: proposalID,
+		Email:      identity.Email,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete activity proposal vote", zap.Error(err), zap.String("proposalID", _proposalID))
+		return spec.DeleteTripsTripIDProposalsProposalIDVoteJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDProposalsProposalIDVoteJSON400Response(spec.Error{Message: "Você não votou nessa proposta."})
+	}
+
+	return spec.DeleteTripsTripIDProposalsProposalIDVoteJSON204Response(struct{}{})
+}
+
+// PostTripsTripIDProposalsProposalIDPromote Promote an activity proposal to a scheduled activity.
+// (POST /trips/{tripId}/proposals/{proposalId}/promote)
+func (api API) PostTripsTripIDProposalsProposalIDPromote(_ http.ResponseWriter, r *http.Request, _tripID string, _proposalID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	proposalID, err := uuid.Parse(_proposalID)
+	if err != nil {
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(spec.Error{Message: "ID de proposta inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeTripChange(r.Context(), tripID, ownerAccess)
+	if apiErr != nil {
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PostTripsTripIDProposalsProposalIDPromoteJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(invalidInput(err))
+	}
+
+	proposal, apiErr := api.getOpenActivityProposal(r.Context(), tripID, proposalID)
+	if apiErr != nil {
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(*apiErr)
+	}
+
+	options, err := api.repository.GetActivityProposalOptions(r.Context(), proposal.ID)
+	if err != nil {
+		api.logger.Error("failed to get activity proposal options", zap.Error(err), zap.String("proposalID", _proposalID))
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	title := proposal.Title
+	occursAt := proposal.OccursAt
+
+	if len(options) > 0 {
+		var option pgstore.ActivityProposalOption
+		if body.OptionID != nil {
+			var ok bool
+			if option, ok = findActivityProposalOption(options, *body.OptionID); !ok {
+				return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(spec.Error{
+					Message: "Input inválido.",
+					Errors:  []spec.FieldError{{Field: "option_id", Message: "Opção não encontrada nessa enquete."}},
+				})
+			}
+		} else {
+			votes, err := api.repository.GetTripActivityProposalVotes(r.Context(), tripID)
+			if err != nil {
+				api.logger.Error("failed to get activity proposal votes", zap.Error(err), zap.String("tripID", _tripID))
+				return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+			}
+
+			var ok bool
+			if option, ok = winningActivityProposalOption(proposal.ID, options, votes); !ok {
+				return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(spec.Error{
+					Message: "Input inválido.",
+					Errors: []spec.FieldError{{
+						Field:   "option_id",
+						Message: "Há um empate entre as opções mais votadas, escolha uma delas em option_id.",
+					}},
+				})
+			}
+		}
+
+		title = option.Label
+		if option.OccursAt.Valid {
+			occursAt = option.OccursAt
+		}
+	} else if body.OptionID != nil {
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(spec.Error{
+			Message: "Input inválido.",
+			Errors:  []spec.FieldError{{Field: "option_id", Message: "Essa proposta não é uma enquete."}},
+		})
+	}
+
+	if body.Title != nil {
+		title = *body.Title
+	}
+	if body.OccursAt != nil {
+		occursAt = pgtype.Timestamptz{Time: *body.OccursAt, Valid: true}
+	}
+
+	if !occursAt.Valid {
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(spec.Error{
+			Message: "Input inválido.",
+			Errors:  []spec.FieldError{{Field: "occurs_at", Message: "Informe quando a atividade vai acontecer."}},
+		})
+	}
+
+	// the trip may have been rescheduled since the proposal was made
+	if apiErr := checkActivityDate(trip, occursAt.Time); apiErr != nil {
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(*apiErr)
+	}
+
+	activityID, promoted, err := api.repository.PromoteActivityProposal(r.Context(), api.pool, proposal.ID, pgstore.CreateActivityParams{
+		TripID:   tripID,
+		Title:    title,
+		OccursAt: occursAt,
+	})
+	if err != nil {
+		api.logger.Error("failed to promote activity proposal", zap.Error(err), zap.String("proposalID", _proposalID))
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if !promoted {
+		return spec.PostTripsTripIDProposalsProposalIDPromoteJSON400Response(spec.Error{Message: "Essa proposta já foi promovida a atividade."})
+	}
+
+	return spec.PostTripsTripIDProposalsProposalIDPromoteJSON201Response(spec.PromoteActivityProposalResponse{
+		ActivityID: activityID.String(),
+	})
+}
+
+// authorizeVoter checks that email may vote on the trip's proposals, which is limited to the owner and to the
+// participants that confirmed their presence.
+func (api API) authorizeVoter(ctx context.Context, trip pgstore.Trip, email string) (int, *spec.Error) {
+	if strings.EqualFold(trip.OwnerEmail, email) {
+		return http.StatusOK, nil
+	}
+
+	confirmed, err := api.repository.IsConfirmedParticipant(ctx, pgstore.IsConfirmedParticipantParams{
+		TripID: trip.ID,
+		Email:  email,
+	})
+	if err != nil {
+		api.logger.Error("failed to check participant's status", zap.Error(err), zap.String("tripID", trip.ID.String()))
+		return http.StatusBadRequest, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	if !confirmed {
+		return http.StatusForbidden, &spec.Error{Message: "Apenas participantes que confirmaram presença podem votar."}
+	}
+
+	return http.StatusOK, nil
+}
+
+func (api API) getActivityProposal(ctx context.Context, tripID uuid.UUID, proposalID uuid.UUID) (pgstore.ActivityProposal, *spec.Error) {
+	proposal, err := api.repository.GetActivityProposal(ctx, pgstore.GetActivityProposalParams{ID: proposalID, TripID: tripID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return proposal, &spec.Error{Message: "Proposta não encontrada."}
+		}
+
+		api.logger.Error("failed to get activity proposal", zap.Error(err), zap.String("proposalID", proposalID.String()))
+		return proposal, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	return proposal, nil
+}
+
+// getOpenActivityProposal is getActivityProposal for routes that are refused once the proposal was promoted.
+func (api API) getOpenActivityProposal(ctx context.Context, tripID uuid.UUID, proposalID uuid.UUID) (pgstore.ActivityProposal, *spec.Error) {
+	proposal, apiErr := api.getActivityProposal(ctx, tripID, proposalID)
+	if apiErr != nil {
+		return proposal, apiErr
+	}
+
+	if proposal.PromotedAt.Valid {
+		return proposal, &spec.Error{Message: "Essa proposta já foi promovida a atividade."}
+	}
+
+	return proposal, nil
+}
+
+func findActivityProposalOption(options []pgstore.ActivityProposalOption, _optionID string) (pgstore.ActivityProposalOption, bool) {
+	optionID, err := uuid.Parse(_optionID)
+	if err != nil {
+		return pgstore.ActivityProposalOption{}, false
+	}
+
+	for _, option := range options {
+		if option.ID == optionID {
+			return option, true
+		}
+	}
+
+	return pgstore.ActivityProposalOption{}, false
+}
+
+// winningActivityProposalOption returns the most voted option of the poll. It reports false when the first place is
+// tied, since the owner has to pick one of the tied options then.
+func winningActivityProposalOption(proposalID uuid.UUID, options []pgstore.ActivityProposalOption, votes []pgstore.ActivityProposalVote) (pgstore.ActivityProposalOption, bool) {
+	count := make(map[uuid.UUID]int, len(options))
+	for _, vote := range votes {
+		if vote.ProposalID == proposalID && vote.OptionID.Valid {
+			count[vote.OptionID.Bytes]++
+		}
+	}
+
+	var winner pgstore.ActivityProposalOption
+	most, tied := -1, false
+	for _, option := range options {
+		switch {
+		case count[option.ID] > most:
+			winner, most, tied = option, count[option.ID], false
+		case count[option.ID] == most:
+			tied = true
+		}
+	}
+
+	return winner, !tied
+}
+
+// tripActivityProposals lists the trip's proposals with their results, along with the vote of the given member.
+func (api API) tripActivityProposals(ctx context.Context, trip pgstore.Trip, email string) ([]spec.ActivityProposal, *spec.Error) {
+	proposals, err := api.repository.GetTripActivityProposals(ctx, trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip's activity proposals", zap.Error(err), zap.String("tripID", trip.ID.String()))
+		return nil, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	options, err := api.repository.GetTripActivityProposalOptions(ctx, trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip's activity proposal options", zap.Error(err), zap.String("tripID", trip.ID.String()))
+		return nil, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	votes, err := api.repository.GetTripActivityProposalVotes(ctx, trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip's activity proposal votes", zap.Error(err), zap.String("tripID", trip.ID.String()))
+		return nil, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	return specActivityProposals(proposals, options, votes, tripLocation(trip), email), nil
+}
+
+// specActivityProposals tallies the votes of each proposal. Options are expected to be sorted by position.
+func specActivityProposals(proposals []pgstore.ActivityProposal, options []pgstore.ActivityProposalOption, votes []pgstore.ActivityProposalVote, location *time.Location, email string) []spec.ActivityProposal {
+	mappedProposals := make([]spec.ActivityProposal, len(proposals))
+	byID := make(map[uuid.UUID]*spec.ActivityProposal, len(proposals))
+	for i, proposal := range proposals {
+		mappedProposals[i] = spec.ActivityProposal{
+			ID:         proposal.ID.String(),
+			Title:      proposal.Title,
+			ProposedBy: types.Email(proposal.ProposedBy),
+			Kind:       "vote",
+			Status:     "proposed",
+			Options:    []spec.ActivityProposalOption{},
+			CreatedAt:  proposal.CreatedAt.Time,
+		}
+		if proposal.OccursAt.Valid {
+			occursAt, occursAtLocal := proposal.OccursAt.Time.UTC(), proposal.OccursAt.Time.In(location)
+			mappedProposals[i].OccursAt, mappedProposals[i].OccursAtLocal = &occursAt, &occursAtLocal
+		}
+
+		if proposal.PromotedAt.Valid {
+			mappedProposals[i].Status = "promoted"
+		}
+
+		if proposal.ActivityID.Valid {
+			activityID := uuid.UUID(proposal.ActivityID.Bytes).String()
+			mappedProposals[i].ActivityID = &activityID
+		}
+
+		byID[proposal.ID] = &mappedProposals[i]
+	}
+
+	optionIndex := make(map[uuid.UUID]int, len(options))
+	for _, option := range options {
+		proposal, ok := byID[option.ProposalID]
+		if !ok {
+			continue
+		}
+
+		mappedOption := spec.ActivityProposalOption{ID: option.ID.String(), Label: option.Label}
+		if option.OccursAt.Valid {
+			occursAt := option.OccursAt.Time.UTC()
+			mappedOption.OccursAt = &occursAt
+		}
+
+		proposal.Kind = "poll"
+		optionIndex[option.ID] = len(proposal.Options)
+		proposal.Options = append(proposal.Options, mappedOption)
+	}
+
+	for _, vote := range votes {
+		proposal, ok := byID[vote.ProposalID]
+		if !ok {
+			continue
+		}
+
+		mine := strings.EqualFold(vote.Email, email)
+		switch {
+		case vote.OptionID.Valid:
+			index, ok := optionIndex[vote.OptionID.Bytes]
+			if !ok {
+				continue
+			}
+			proposal.Options[index].Votes++
+
+			if mine {
+				proposal.MyOptionID = &proposal.Options[index].ID
+			}
+		case vote.Value.Int16 > 0:
+			proposal.Upvotes++
+		default:
+			proposal.Downvotes++
+		}
+
+		if mine && vote.Value.Valid {
+			myVote := "up"
+			if vote.Value.Int16 < 0 {
+				myVote = "down"
+			}
+			proposal.MyVote = &myVote
+		}
+	}
+
+	for i := range mappedProposals {
+		mappedProposals[i].Score = mappedProposals[i].Upvotes - mappedProposals[i].Downvotes
+	}
+
+	return mappedProposals
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func valueVote(proposalID uuid.UUID, email string, value int16) pgstore.ActivityProposalVote {
+	return pgstore.ActivityProposalVote{ProposalID: proposalID, Email: email, Value: pgtype.Int2{Int16: value, Valid: true}}
+}
+
+func optionVote(proposalID uuid.UUID, email string, optionID uuid.UUID) pgstore.ActivityProposalVote {
+	return pgstore.ActivityProposalVote{ProposalID: proposalID, Email: email, OptionID: pgtype.UUID{Bytes: optionID, Valid: true}}
+}
+
+func TestWinningActivityProposalOption(t *testing.T) {
+	proposalID, otherProposalID := uuid.New(), uuid.New()
+	options := []pgstore.ActivityProposalOption{
+		{ID: uuid.New(), ProposalID: proposalID, Label: "Praia", Position: 0},
+		{ID: uuid.New(), ProposalID: proposalID, Label: "Trilha", Position: 1},
+		{ID: uuid.New(), ProposalID: proposalID, Label: "Museu", Position: 2},
+	}
+	beach, trail, museum := options[0], options[1], options[2]
+
+	tests := []struct {
+		name       string
+		votes      []pgstore.ActivityProposalVote
+		want       pgstore.ActivityProposalOption
+		wantWinner bool
+	}{
+		{
+			name: "most voted option",
+			votes: []pgstore.ActivityProposalVote{
+				optionVote(proposalID, "ana@example.com", trail.ID),
+				optionVote(proposalID, "bia@example.com", trail.ID),
+				optionVote(proposalID, "caio@example.com", museum.ID),
+			},
+			want:       trail,
+			wantWinner: true,
+		},
+		{
+			name: "tie for first place",
+			votes: []pgstore.ActivityProposalVote{
+				optionVote(proposalID, "ana@example.com", beach.ID),
+				optionVote(proposalID, "bia@example.com", museum.ID),
+			},
+			want:       beach,
+			wantWinner: false,
+		},
+		{
+			name: "tie below first place",
+			votes: []pgstore.ActivityProposalVote{
+				optionVote(proposalID, "ana@example.com", museum.ID),
+				optionVote(proposalID, "bia@example.com", museum.ID),
+				optionVote(proposalID, "caio@example.com", beach.ID),
+				optionVote(proposalID, "davi@example.com", trail.ID),
+			},
+			want:       museum,
+			wantWinner: true,
+		},
+		{
+			name:       "no votes",
+			want:       beach,
+			wantWinner: false,
+		},
+		{
+			name: "votes of other proposals and up votes are ignored",
+			votes: []pgstore.ActivityProposalVote{
+				optionVote(otherProposalID, "ana@example.com", beach.ID),
+				optionVote(otherProposalID, "bia@example.com", beach.ID),
+				valueVote(proposalID, "caio@example.com", 1),
+				optionVote(proposalID, "davi@example.com", museum.ID),
+			},
+			want:       museum,
+			wantWinner: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := winningActivityProposalOption(proposalID, options, test.votes)
+			if ok != test.wantWinner {
+				t.Fatalf("winningActivityProposalOption() ok = %t, want %t", ok, test.wantWinner)
+			}
+			if ok && got != test.want {
+				t.Errorf("winningActivityProposalOption() = %s, want %s", got.Label, test.want.Label)
+			}
+		})
+	}
+}
+
+func TestSpecActivityProposals(t *testing.T) {
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	createdAt := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	occursAt := time.Date(2024, 1, 10, 13, 0, 0, 0, time.UTC)
+	occursAtLocal := occursAt.In(location)
+
+	voted := pgstore.ActivityProposal{
+		ID:         uuid.New(),
+		Title:      "Passeio de barco",
+		ProposedBy: "ana@example.com",
+		OccursAt:   pgtype.Timestamptz{Time: occursAt, Valid: true},
+		CreatedAt:  pgtype.Timestamptz{Time: createdAt, Valid: true},
+	}
+	poll := pgstore.ActivityProposal{
+		ID:         uuid.New(),
+		Title:      "Jantar",
+		ProposedBy: "bia@example.com",
+		CreatedAt:  pgtype.Timestamptz{Time: createdAt, Valid: true},
+	}
+	options := []pgstore.ActivityProposalOption{
+		{ID: uuid.New(), ProposalID: poll.ID, Label: "Pizza", Position: 0},
+		{ID: uuid.New(), ProposalID: poll.ID, Label: "Sushi", Position: 1},
+		{ID: uuid.New(), ProposalID: uuid.New(), Label: "Opção de outra viagem", Position: 0},
+	}
+	votes := []pgstore.ActivityProposalVote{
+		valueVote(voted.ID, "ana@example.com", 1),
+		valueVote(voted.ID, "bia@example.com", 1),
+		valueVote(voted.ID, "caio@example.com", -1),
+		optionVote(poll.ID, "ana@example.com", options[1].ID),
+		optionVote(poll.ID, "bia@example.com", options[1].ID),
+		optionVote(poll.ID, "caio@example.com", options[0].ID),
+		valueVote(uuid.New(), "ana@example.com", -1),
+	}
+
+	myVote, myOptionID := "up", options[1].ID.String()
+	want := []spec.ActivityProposal{
+		{
+			ID:            voted.ID.String(),
+			Title:         voted.Title,
+			ProposedBy:    "ana@example.com",
+			Kind:          "vote",
+			Status:        "proposed",
+			Options:       []spec.ActivityProposalOption{},
+			CreatedAt:     createdAt,
+			OccursAt:      &occursAt,
+			OccursAtLocal: &occursAtLocal,
+			Upvotes:       2,
+			Downvotes:     1,
+			Score:         1,
+			MyVote:        &myVote,
+		},
+		{
+			ID:         poll.ID.String(),
+			Title:      poll.Title,
+			ProposedBy: "bia@example.com",
+			Kind:       "poll",
+			Status:     "proposed",
+			Options: []spec.ActivityProposalOption{
+				{ID: options[0].ID.String(), Label: "Pizza", Votes: 1},
+				{ID: options[1].ID.String(), Label: "Sushi", Votes: 2},
+			},
+			CreatedAt:  createdAt,
+			MyOptionID: &myOptionID,
+		},
+	}
+
+	got := specActivityProposals([]pgstore.ActivityProposal{voted, poll}, options, votes, location, "ANA@example.com")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("specActivityProposals() = %+v, want %+v", got, want)
+	}
+}
@@ -8,12 +8,16 @@ import (
 	"go.uber.org/zap"
 	"nlw-journey/internal/api/spec"
 	"nlw-journey/internal/pgstore"
+	"nlw-journey/internal/token"
+	"os"
+	"strings"
+	"time"
 )
 
 type Repository interface {
 	GetParticipant(context.Context, uuid.UUID) (pgstore.Participant, error)
-	ConfirmParticipant(context.Context, uuid.UUID) error
-	CreateTrip(context.Context, *pgxpool.Pool, spec.PostTripsJSONBody) (uuid.UUID, error)
+	AnswerRSVP(context.Context, *pgxpool.Pool, pgstore.UpdateParticipantStatusParams, *pgstore.UseTokenParams) error
+	CreateTrip(context.Context, *pgxpool.Pool, spec.PostTripsJSONBody, []pgstore.CreateActivityParams, []pgstore.CreateTripLinkParams) (uuid.UUID, error)
 	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
 	UpdateTrip(context.Context, pgstore.UpdateTripParams) error
 	GetTripActivities(context.Context, uuid.UUID) ([]pgstore.Activity, error)
@@ -23,11 +27,75 @@ type Repository interface {
 	InsertTrip(context.Context, pgstore.InsertTripParams) (uuid.UUID, error)
 	CreateActivity(context.Context, pgstore.CreateActivityParams) (uuid.UUID, error)
 	InviteParticipantsToTrip(ctx context.Context, arg []pgstore.InviteParticipantsToTripParams) (int64, error)
-}
-
-type Mailer interface {
-	SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error
-	SendConfirmedTripNotificationEmail(trip pgstore.Trip) error
+	InviteParticipant(context.Context, *pgxpool.Pool, pgstore.Trip, string) (uuid.UUID, error)
+	ConfirmTrip(context.Context, *pgxpool.Pool, pgstore.Trip, *pgstore.UseTokenParams) error
+	UseToken(context.Context, pgstore.UseTokenParams) (int64, error)
+	IsTokenUsed(context.Context, uuid.UUID) (bool, error)
+	IsKnownEmail(context.Context, string) (bool, error)
+	IsTripMember(context.Context, pgstore.IsTripMemberParams) (bool, error)
+	EnqueueMail(context.Context, string, pgstore.MailPayload) error
+	DeleteTrip(context.Context, uuid.UUID) (int64, error)
+	DeleteActivity(context.Context, pgstore.DeleteActivityParams) (int64, error)
+	DeleteLink(context.Context, pgstore.DeleteLinkParams) (int64, error)
+	RemoveParticipant(context.Context, *pgxpool.Pool, pgstore.Participant, bool) (bool, error)
+	UpdateActivity(context.Context, pgstore.UpdateActivityParams) (int64, error)
+	UpdateLink(context.Context, pgstore.UpdateLinkParams) (int64, error)
+	RescheduleTrip(context.Context, *pgxpool.Pool, pgstore.UpdateTripParams, time.Duration, []uuid.UUID) error
+	CancelTrip(context.Context, *pgxpool.Pool, pgstore.Trip) error
+	ListTrips(context.Context, pgstore.ListTripsParams) ([]pgstore.Trip, error)
+	ListTripsDesc(context.Context, pgstore.ListTripsDescParams) ([]pgstore.Trip, error)
+	RotateCalendarFeed(context.Context, *pgxpool.Pool, string, []byte) (uuid.UUID, error)
+	RevokeCalendarFeeds(context.Context, string) (int64, error)
+	GetCalendarFeedByTokenHash(context.Context, []byte) (pgstore.CalendarFeed, error)
+	GetMemberTrips(context.Context, string) ([]pgstore.Trip, error)
+	GetActivitiesOfTrips(context.Context, []uuid.UUID) ([]pgstore.Activity, error)
+	GetLinksOfTrips(context.Context, []uuid.UUID) ([]pgstore.Link, error)
+	ImportTrip(context.Context, *pgxpool.Pool, spec.TripBundle) (uuid.UUID, error)
+	CloneTrip(context.Context, *pgxpool.Pool, pgstore.Trip, time.Time, bool) (uuid.UUID, error)
+	CreateTripTemplate(context.Context, *pgxpool.Pool, string, spec.TripTemplateInput) (uuid.UUID, error)
+	ReplaceTripTemplate(context.Context, *pgxpool.Pool, uuid.UUID, spec.TripTemplateInput) error
+	GetTripTemplate(context.Context, uuid.UUID) (pgstore.TripTemplate, error)
+	ListTripTemplates(context.Context, string) ([]pgstore.TripTemplate, error)
+	DeleteTripTemplate(context.Context, uuid.UUID) (int64, error)
+	GetTripTemplateActivities(context.Context, uuid.UUID) ([]pgstore.TripTemplateActivity, error)
+	GetTripTemplateLinks(context.Context, uuid.UUID) ([]pgstore.TripTemplateLink, error)
+	CreateExpense(context.Context, *pgxpool.Pool, pgstore.InsertExpenseParams, []pgstore.InsertExpenseSplitParams) (uuid.UUID, error)
+	ReplaceExpense(context.Context, *pgxpool.Pool, pgstore.UpdateExpenseParams, []pgstore.InsertExpenseSplitParams) (bool, error)
+	GetExpense(context.Context, pgstore.GetExpenseParams) (pgstore.Expense, error)
+	GetTripExpenses(context.Context, uuid.UUID) ([]pgstore.Expense, error)
+	DeleteExpense(context.Context, pgstore.DeleteExpenseParams) (int64, error)
+	GetExpenseSplits(context.Context, uuid.UUID) ([]pgstore.ExpenseSplit, error)
+	GetTripExpenseSplits(context.Context, uuid.UUID) ([]pgstore.ExpenseSplit, error)
+	IsTripActivity(context.Context, pgstore.IsTripActivityParams) (bool, error)
+	InsertSettlement(context.Context, pgstore.InsertSettlementParams) (uuid.UUID, error)
+	GetTripSettlements(context.Context, uuid.UUID) ([]pgstore.Settlement, error)
+	DeleteSettlement(context.Context, pgstore.DeleteSettlementParams) (int64, error)
+	GetExchangeRate(context.Context, pgstore.GetExchangeRateParams) (pgstore.ExchangeRate, error)
+	GetTripBudgets(context.Context, uuid.UUID) ([]pgstore.TripBudget, error)
+	ReplaceTripBudgets(context.Context, *pgxpool.Pool, uuid.UUID, []pgstore.InsertTripBudgetParams) error
+	TripHasExpensesOrBudgets(context.Context, uuid.UUID) (bool, error)
+	InsertChecklist(context.Context, pgstore.InsertChecklistParams) (uuid.UUID, error)
+	UpdateChecklist(context.Context, pgstore.UpdateChecklistParams) (int64, error)
+	DeleteChecklist(context.Context, pgstore.DeleteChecklistParams) (int64, error)
+	GetTripChecklists(context.Context, uuid.UUID) ([]pgstore.Checklist, error)
+	IsTripChecklist(context.Context, pgstore.IsTripChecklistParams) (bool, error)
+	InsertChecklistItem(context.Context, pgstore.InsertChecklistItemParams) (uuid.UUID, error)
+	UpdateChecklistItem(context.Context, pgstore.UpdateChecklistItemParams) (int64, error)
+	DeleteChecklistItem(context.Context, pgstore.DeleteChecklistItemParams) (int64, error)
+	GetChecklistItems(context.Context, uuid.UUID) ([]pgstore.ChecklistItem, error)
+	GetTripChecklistItems(context.Context, uuid.UUID) ([]pgstore.ChecklistItem, error)
+	ReorderChecklistItems(context.Context, *pgxpool.Pool, uuid.UUID, []uuid.UUID) error
+	CreateActivityProposal(context.Context, *pgxpool.Pool, pgstore.InsertActivityProposalParams, []pgstore.InsertActivityProposalOptionParams) (uuid.UUID, error)
+	GetActivityProposal(context.Context, pgstore.GetActivityProposalParams) (pgstore.ActivityProposal, error)
+	GetTripActivityProposals(context.Context, uuid.UUID) ([]pgstore.ActivityProposal, error)
+	DeleteActivityProposal(context.Context, pgstore.DeleteActivityProposalParams) (int64, error)
+	GetActivityProposalOptions(context.Context, uuid.UUID) ([]pgstore.ActivityProposalOption, error)
+	GetTripActivityProposalOptions(context.Context, uuid.UUID) ([]pgstore.ActivityProposalOption, error)
+	UpsertActivityProposalVote(context.Context, pgstore.UpsertActivityProposalVoteParams) error
+	DeleteActivityProposalVote(context.Context, pgstore.DeleteActivityProposalVoteParams) (int64, error)
+	GetTripActivityProposalVotes(context.Context, uuid.UUID) ([]pgstore.ActivityProposalVote, error)
+	IsConfirmedParticipant(context.Context, pgstore.IsConfirmedParticipantParams) (bool, error)
+	PromoteActivityProposal(context.Context, *pgxpool.Pool, uuid.UUID, pgstore.CreateActivityParams) (uuid.UUID, bool, error)
 }
 
 type API struct {
@@ -35,17 +103,21 @@ type API struct {
 	pool       *pgxpool.Pool
 	logger     *zap.Logger
 	validator  *validator.Validate
-	mailer     Mailer
-}
+	signer     token.Signer
+	apiURL     string
 
-func NewAPI(pool *pgxpool.Pool, logger *zap.Logger, mailer Mailer) API {
-	_validator := validator.New(validator.WithRequiredStructEnabled())
+	// legacyConfirmation keeps the confirmation routes that take bare IDs instead of signed tokens working.
+	legacyConfirmation bool
+}
 
+func NewAPI(pool *pgxpool.Pool, logger *zap.Logger, signer token.Signer, legacyConfirmation bool) API {
 	return API{
 		pgstore.New(pool),
 		pool,
 		logger,
-		_validator,
-		mailer,
+		newValidator(),
+		signer,
+		strings.TrimSuffix(os.Getenv("JOURNEY_API_URL"), "/"),
+		legacyConfirmation,
 	}
 }
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"strings"
+)
+
+// Identity is the authenticated user of a request. Users are identified by their e-mail, which is how trips
+// reference both their owners and participants.
+type Identity struct {
+	Email string
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a copy of ctx carrying the authenticated identity.
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext returns the authenticated identity of the request, if there is one.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// tripAccess tells which relationship with a trip a route requires.
+type tripAccess int
+
+const (
+	// memberAccess is granted to the trip owner and to its participants.
+	memberAccess tripAccess = iota
+	// ownerAccess is only granted to the trip owner.
+	ownerAccess
+)
+
+// authorizeTrip loads the trip and checks whether the authenticated identity has the given access to it.
+// On failure, it returns the status code and the error that should be sent back to the client.
+func (api API) authorizeTrip(ctx context.Context, tripID uuid.UUID, access tripAccess) (pgstore.Trip, int, *spec.Error) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return pgstore.Trip{}, http.StatusUnauthorized, &spec.Error{Message: "É necessário estar autenticado."}
+	}
+
+	trip, err := api.repository.GetTrip(ctx, tripID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return trip, http.StatusBadRequest, &spec.Error{Message: "Viagem não encontrada."}
+		}
+
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("tripID", tripID.String()))
+		return trip, http.StatusBadRequest, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	if strings.EqualFold(trip.OwnerEmail, identity.Email) {
+		return trip, http.StatusOK, nil
+	}
+
+	if access == ownerAccess {
+		return trip, http.StatusForbidden, &spec.Error{Message: "Apenas o dono da viagem pode fazer isso."}
+	}
+
+	isMember, err := api.repository.IsTripMember(ctx, pgstore.IsTripMemberParams{
+		TripID: tripID,
+		Email:  identity.Email,
+	})
+	if err != nil {
+		api.logger.Error("failed to check trip membership", zap.Error(err), zap.String("tripID", tripID.String()))
+		return trip, http.StatusBadRequest, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	if !isMember {
+		return trip, http.StatusForbidden, &spec.Error{Message: "Você não participa dessa viagem."}
+	}
+
+	return trip, http.StatusOK, nil
+}
+
+// authorizeTripChange is authorizeTrip for routes that modify the trip or its items, which are refused once the
+// trip was cancelled or completed.
+func (api API) authorizeTripChange(ctx context.Context, tripID uuid.UUID, access tripAccess) (pgstore.Trip, int, *spec.Error) {
+	trip, status, apiErr := api.authorizeTrip(ctx, tripID, access)
+	if apiErr != nil {
+		return trip, status, apiErr
+	}
+
+	if apiErr := ensureTripIsEditable(trip); apiErr != nil {
+		return trip, http.StatusBadRequest, apiErr
+	}
+
+	return trip, http.StatusOK, nil
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"sort"
+	"strings"
+)
+
+// GetTripsTripIDBalances Get the balance of each member and the transfers that settle up the trip.
+// (GET /trips/{tripId}/balances)
+func (api API) GetTripsTripIDBalances(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.GetTripsTripIDBalancesJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTrip(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.GetTripsTripIDBalancesJSON400Response(*apiErr).Status(status)
+	}
+
+	expenses, err := api.repository.GetTripExpenses(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's expenses", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDBalancesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	splits, err := api.repository.GetTripExpenseSplits(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's expense splits", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDBalancesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	settlements, err := api.repository.GetTripSettlements(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's settlements", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDBalancesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.GetTripsTripIDBalancesJSON200Response(spec.GetTripBalancesResponse{
+		Currencies: tripBalances(expenses, splits, settlements),
+	})
+}
+
+// tripBalances adds up what each member paid and owes, in each currency. Members are told apart by their e-mail,
+// ignoring its case, and listed in alphabetical order.
+func tripBalances(expenses []pgstore.Expense, splits []pgstore.ExpenseSplit, settlements []pgstore.Settlement) []spec.CurrencyBalances {
+	balances := make(map[string]map[string]*spec.ParticipantBalance)
+	balanceOf := func(currency string, email string) *spec.ParticipantBalance {
+		if balances[currency] == nil {
+			balances[currency] = make(map[string]*spec.ParticipantBalance)
+		}
+
+		key := strings.ToLower(email)
+		if balances[currency][key] == nil {
+			balances[currency][key] = &spec.ParticipantBalance{Email: types.Email(email)}
+		}
+
+		return balances[currency][key]
+	}
+
+	currencyOf := make(map[uuid.UUID]string, len(expenses))
+	for _, expense := range expenses {
+		currencyOf[expense.ID] = expense.Currency
+		balanceOf(expense.Currency, expense.PayerEmail).Paid += expense.Amount
+	}
+
+	for _, split := range splits {
+		balanceOf(currencyOf[split.ExpenseID], split.Email).Owed += split.Amount
+	}
+
+	for _, settlement := range settlements {
+		balanceOf(settlement.Currency, settlement.FromEmail).Sent += settlement.Amount
+		balanceOf(settlement.Currency, settlement.ToEmail).Received += settlement.Amount
+	}
+
+	currencies := make([]spec.CurrencyBalances, 0, len(balances))
+	for currency, members := range balances {
+		participants := make([]spec.ParticipantBalance, 0, len(members))
+		for _, balance := range members {
+			balance.Balance = balance.Paid - balance.Owed + balance.Sent - balance.Received
+			participants = append(participants, *balance)
+		}
+
+		sort.Slice(participants, func(i, j int) bool {
+			return strings.ToLower(string(participants[i].Email)) < strings.ToLower(string(participants[j].Email))
+		})
+
+		currencies = append(currencies, spec.CurrencyBalances{
+			Currency:     currency,
+			Participants: participants,
+			Transfers:    settleUp(participants),
+		})
+	}
+
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i].Currency < currencies[j].Currency
+	})
+
+	return currencies
+}
+
+// settleUp lists the transfers that bring every balance to zero. Members owing and owed the exact same amount are
+// paired first, then the largest debt is paid to the largest credit until nothing is left, which never takes more than
+// one transfer less than the number of members. Ties are broken by e-mail, so the transfers only depend on the
+// balances. Since expenses are split in whole minor units, balances always add up to exactly zero.
+func settleUp(balances []spec.ParticipantBalance) []spec.SettleUpTransfer {
+	type position struct {
+		email  types.Email
+		amount int64
+	}
+
+	var debtors, creditors []position
+	for _, balance := range balances {
+		if balance.Balance < 0 {
+			debtors = append(debtors, position{balance.Email, -balance.Balance})
+		}
+		if balance.Balance > 0 {
+			creditors = append(creditors, position{balance.Email, balance.Balance})
+		}
+	}
+
+	largestFirst := func(positions []position) {
+		sort.SliceStable(positions, func(i, j int) bool {
+			if positions[i].amount != positions[j].amount {
+				return positions[i].amount > positions[j].amount
+			}
+			return strings.ToLower(string(positions[i].email)) < strings.ToLower(string(positions[j].email))
+		})
+	}
+
+	largestFirst(debtors)
+	largestFirst(creditors)
+
+	transfers := make([]spec.SettleUpTransfer, 0)
+	for i := range debtors {
+		for j := range creditors {
+			if debtors[i].amount > 0 && debtors[i].amount == creditors[j].amount {
+				transfers = append(transfers, spec.SettleUpTransfer{
+					FromEmail: debtors[i].email,
+					ToEmail:   creditors[j].email,
+					Amount:    debtors[i].amount,
+				})
+				debtors[i].amount, creditors[j].amount = 0, 0
+				break
+			}
+		}
+	}
+
+	for {
+		largestFirst(debtors)
+		largestFirst(creditors)
+		if len(debtors) == 0 || len(creditors) == 0 || debtors[0].amount == 0 || creditors[0].amount == 0 {
+			return transfers
+		}
+
+		amount := min(debtors[0].amount, creditors[0].amount)
+		transfers = append(transfers, spec.SettleUpTransfer{
+			FromEmail: debtors[0].email,
+			ToEmail:   creditors[0].email,
+			Amount:    amount,
+		})
+		debtors[0].amount -= amount
+		creditors[0].amount -= amount
+	}
+}
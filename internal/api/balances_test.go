@@ -0,0 +1,191 @@
+package api
+
+import (
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func balance(email string, amount int64) spec.ParticipantBalance {
+	return spec.ParticipantBalance{Email: types.Email(email), Balance: amount}
+}
+
+func transfer(from, to string, amount int64) spec.SettleUpTransfer {
+	return spec.SettleUpTransfer{FromEmail: types.Email(from), ToEmail: types.Email(to), Amount: amount}
+}
+
+func TestSettleUp(t *testing.T) {
+	tests := []struct {
+		name     string
+		balances []spec.ParticipantBalance
+		want     []spec.SettleUpTransfer
+	}{
+		{
+			name:     "nothing to settle",
+			balances: []spec.ParticipantBalance{balance("ana@example.com", 0), balance("bia@example.com", 0)},
+			want:     []spec.SettleUpTransfer{},
+		},
+		{
+			name: "exact amounts are paired before the rest",
+			balances: []spec.ParticipantBalance{
+				balance("ana@example.com", -50),
+				balance("bia@example.com", -20),
+				balance("caio@example.com", 40),
+				balance("davi@example.com", 20),
+				balance("enzo@example.com", 10),
+			},
+			want: []spec.SettleUpTransfer{
+				transfer("bia@example.com", "davi@example.com", 20),
+				transfer("ana@example.com", "caio@example.com", 40),
+				transfer("ana@example.com", "enzo@example.com", 10),
+			},
+		},
+		{
+			name: "largest debt is paid to the largest credit",
+			balances: []spec.ParticipantBalance{
+				balance("ana@example.com", -70),
+				balance("bia@example.com", -50),
+				balance("caio@example.com", 80),
+				balance("davi@example.com", 40),
+			},
+			want: []spec.SettleUpTransfer{
+				transfer("ana@example.com", "caio@example.com", 70),
+				transfer("bia@example.com", "davi@example.com", 40),
+				transfer("bia@example.com", "caio@example.com", 10),
+			},
+		},
+		{
+			name: "ties are broken by e-mail",
+			balances: []spec.ParticipantBalance{
+				balance("davi@example.com", 10),
+				balance("bia@example.com", -10),
+				balance("caio@example.com", 10),
+				balance("ana@example.com", -10),
+			},
+			want: []spec.SettleUpTransfer{
+				transfer("ana@example.com", "caio@example.com", 10),
+				transfer("bia@example.com", "davi@example.com", 10),
+			},
+		},
+		{
+			name: "e-mails are compared ignoring their case",
+			balances: []spec.ParticipantBalance{
+				balance("Bia@example.com", -10),
+				balance("ana@example.com", -10),
+				balance("davi@example.com", 10),
+				balance("Caio@example.com", 10),
+			},
+			want: []spec.SettleUpTransfer{
+				transfer("ana@example.com", "Caio@example.com", 10),
+				transfer("Bia@example.com", "davi@example.com", 10),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := settleUp(test.balances); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("settleUp() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSettleUpBringsEveryBalanceToZero(t *testing.T) {
+	emails := []string{"ana@example.com", "bia@example.com", "caio@example.com", "davi@example.com", "enzo@example.com", "fabi@example.com"}
+	amounts := [][]int64{
+		{-1, -1, -1, 1, 1, 1},
+		{-333, -333, -334, 500, 250, 250},
+		{-120, 45, 45, -7, 30, 7},
+		{1000, -1, -999, 0, 0, 0},
+		{-5, -5, -5, -5, -5, 25},
+	}
+
+	for _, values := range amounts {
+		balances := make([]spec.ParticipantBalance, len(values))
+		for i, value := range values {
+			balances[i] = balance(emails[i], value)
+		}
+
+		transfers := settleUp(balances)
+		if len(transfers) > len(balances)-1 {
+			t.Errorf("settleUp(%v) took %d transfers, more than %d", values, len(transfers), len(balances)-1)
+		}
+
+		left := make(map[string]int64, len(balances))
+		for _, balance := range balances {
+			left[strings.ToLower(string(balance.Email))] = balance.Balance
+		}
+		for _, transfer := range transfers {
+			if transfer.Amount <= 0 {
+				t.Errorf("settleUp(%v) has a transfer of %d", values, transfer.Amount)
+			}
+			left[strings.ToLower(string(transfer.FromEmail))] += transfer.Amount
+			left[strings.ToLower(string(transfer.ToEmail))] -= transfer.Amount
+		}
+		for email, amount := range left {
+			if amount != 0 {
+				t.Errorf("settleUp(%v) leaves %s with %d", values, email, amount)
+			}
+		}
+
+		reversed := slices.Clone(balances)
+		slices.Reverse(reversed)
+		if got := settleUp(reversed); !reflect.DeepEqual(got, transfers) {
+			t.Errorf("settleUp(%v) depends on the order of the balances: %v and %v", values, transfers, got)
+		}
+	}
+}
+
+func TestTripBalances(t *testing.T) {
+	dinner, taxi := uuid.New(), uuid.New()
+
+	expenses := []pgstore.Expense{
+		{ID: dinner, PayerEmail: "ana@example.com", Amount: 300, Currency: "BRL"},
+		{ID: taxi, PayerEmail: "Bia@example.com", Amount: 50, Currency: "USD"},
+	}
+	splits := []pgstore.ExpenseSplit{
+		{ExpenseID: dinner, Email: "ana@example.com", Amount: 100},
+		{ExpenseID: dinner, Email: "bia@example.com", Amount: 100},
+		{ExpenseID: dinner, Email: "caio@example.com", Amount: 100},
+		{ExpenseID: taxi, Email: "ANA@example.com", Amount: 25},
+		{ExpenseID: taxi, Email: "bia@example.com", Amount: 25},
+	}
+	settlements := []pgstore.Settlement{
+		{FromEmail: "bia@example.com", ToEmail: "ana@example.com", Amount: 50, Currency: "BRL"},
+	}
+
+	want := []spec.CurrencyBalances{
+		{
+			Currency: "BRL",
+			Participants: []spec.ParticipantBalance{
+				{Email: "ana@example.com", Paid: 300, Owed: 100, Received: 50, Balance: 150},
+				{Email: "bia@example.com", Owed: 100, Sent: 50, Balance: -50},
+				{Email: "caio@example.com", Owed: 100, Balance: -100},
+			},
+			Transfers: []spec.SettleUpTransfer{
+				transfer("caio@example.com", "ana@example.com", 100),
+				transfer("bia@example.com", "ana@example.com", 50),
+			},
+		},
+		{
+			Currency: "USD",
+			Participants: []spec.ParticipantBalance{
+				{Email: "ANA@example.com", Owed: 25, Balance: -25},
+				{Email: "Bia@example.com", Paid: 50, Owed: 25, Balance: 25},
+			},
+			Transfers: []spec.SettleUpTransfer{
+				transfer("ANA@example.com", "Bia@example.com", 25),
+			},
+		},
+	}
+
+	if got := tripBalances(expenses, splits, settlements); !reflect.DeepEqual(got, want) {
+		t.Errorf("tripBalances() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,237 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"math/big"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/currency"
+	"nlw-journey/internal/pgstore"
+	"strings"
+	"time"
+)
+
+// expenseCategories lists the categories of expenses and budgets in the order they are shown.
+var expenseCategories = []string{"accommodation", "transport", "food", "activities", "shopping", "other"}
+
+const defaultExpenseCategory = "other"
+
+// exchangeRateScale is the number of decimal places kept in exchange rates, matching the database columns.
+const exchangeRateScale = 12
+
+// GetTripsTripIDBudgets List the trip budgets.
+// (GET /trips/{tripId}/budgets)
+func (api API) GetTripsTripIDBudgets(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.GetTripsTripIDBudgetsJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTrip(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.GetTripsTripIDBudgetsJSON400Response(*apiErr).Status(status)
+	}
+
+	budgets, err := api.repository.GetTripBudgets(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's budgets", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDBudgetsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	mappedBudgets := make([]spec.TripBudget, len(budgets))
+	for i, budget := range budgets {
+		mappedBudgets[i] = spec.TripBudget{
+			Category:     budget.Category,
+			Amount:       budget.Amount,
+			Currency:     budget.Currency,
+			BaseCurrency: budget.BaseCurrency,
+			ExchangeRate: formatExchangeRate(budget.ExchangeRate),
+			BaseAmount:   budget.BaseAmount,
+		}
+	}
+
+	return spec.GetTripsTripIDBudgetsJSON200Response(spec.ListTripBudgetsResponse{Budgets: mappedBudgets})
+}
+
+// PutTripsTripIDBudgets Replace the trip budgets.
+// (PUT /trips/{tripId}/budgets)
+func (api API) PutTripsTripIDBudgets(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PutTripsTripIDBudgetsJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeExpenseChange(r.Context(), tripID, ownerAccess)
+	if apiErr != nil {
+		return spec.PutTripsTripIDBudgetsJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PutTripsTripIDBudgetsJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDBudgetsJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDBudgetsJSON400Response(invalidInput(err))
+	}
+
+	var fields []spec.FieldError
+	seen := make(map[string]int, len(body.Budgets))
+	budgets := make([]pgstore.InsertTripBudgetParams, len(body.Budgets))
+	for i, budget := range body.Budgets {
+		if first, ok := seen[budget.Category]; ok {
+			fields = append(fields, spec.FieldError{
+				Field:   fmt.Sprintf("budgets[%d].category", i),
+				Message: fmt.Sprintf("Categoria repetida, já listada em budgets[%d].", first),
+			})
+			continue
+		}
+		seen[budget.Category] = i
+
+		rate, baseAmount, found, err := api.toBaseCurrency(r.Context(), budget.Amount, budget.Currency, trip.BaseCurrency)
+		if err != nil {
+			api.logger.Error("failed to get exchange rate", zap.Error(err), zap.String("tripID", _tripID))
+			return spec.PutTripsTripIDBudgetsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+		}
+
+		if !found {
+			fields = append(fields, spec.FieldError{
+				Field:   fmt.Sprintf("budgets[%d].currency", i),
+				Message: missingExchangeRateMessage(budget.Currency, trip.BaseCurrency),
+			})
+			continue
+		}
+
+		budgets[i] = pgstore.InsertTripBudgetParams{
+			Category:     budget.Category,
+			Amount:       budget.Amount,
+			Currency:     budget.Currency,
+			BaseCurrency: trip.BaseCurrency,
+			ExchangeRate: rate,
+			BaseAmount:   baseAmount,
+		}
+	}
+
+	if len(fields) > 0 {
+		return spec.PutTripsTripIDBudgetsJSON400Response(spec.Error{Message: "Input inválido.", Errors: fields})
+	}
+
+	if err := api.repository.ReplaceTripBudgets(r.Context(), api.pool, tripID, budgets); err != nil {
+		api.logger.Error("failed to replace trip's budgets", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.PutTripsTripIDBudgetsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PutTripsTripIDBudgetsJSON204Response(struct{}{})
+}
+
+// tripBudgetSummary compares the trip's budgets with what was spent on each category, in the trip's base currency.
+func (api API) tripBudgetSummary(ctx context.Context, trip pgstore.Trip) (spec.TripBudgetSummary, *spec.Error) {
+	budgets, err := api.repository.GetTripBudgets(ctx, trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip's budgets", zap.Error(err), zap.String("tripID", trip.ID.String()))
+		return spec.TripBudgetSummary{}, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	expenses, err := api.repository.GetTripExpenses(ctx, trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip's expenses", zap.Error(err), zap.String("tripID", trip.ID.String()))
+		return spec.TripBudgetSummary{}, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	summary := spec.TripBudgetSummary{
+		BaseCurrency: trip.BaseCurrency,
+		Categories:   make([]spec.BudgetCategorySummary, 0, len(expenseCategories)),
+	}
+
+	budgeted := make(map[string]int64, len(budgets))
+	for _, budget := range budgets {
+		budgeted[budget.Category] += budget.BaseAmount
+	}
+
+	spent := make(map[string]int64)
+	for _, expense := range expenses {
+		if !expense.BaseAmount.Valid || expense.BaseCurrency.String != trip.BaseCurrency {
+			summary.UnconvertedExpenses++
+			continue
+		}
+		spent[expense.Category] += expense.BaseAmount.Int64
+	}
+
+	for _, category := range expenseCategories {
+		if _, hasBudget := budgeted[category]; !hasBudget && spent[category] == 0 {
+			continue
+		}
+
+		summary.Categories = append(summary.Categories, spec.BudgetCategorySummary{
+			Category: category,
+			Budgeted: budgeted[category],
+			Spent:    spent[category],
+		})
+		summary.TotalBudgeted += budgeted[category]
+		summary.TotalSpent += spent[category]
+	}
+
+	return summary, nil
+}
+
+// toBaseCurrency converts amount to the trip's base currency with the latest exchange rate loaded, returning the rate
+// so it can be kept along with the amount. It reports false when no rate between the currencies is in effect yet.
+func (api API) toBaseCurrency(ctx context.Context, amount int64, from string, base string) (pgtype.Numeric, int64, bool, error) {
+	if from == base {
+		return pgtype.Numeric{Int: big.NewInt(1), Valid: true}, amount, true, nil
+	}
+
+	exchangeRate, err := api.repository.GetExchangeRate(ctx, pgstore.GetExchangeRateParams{
+		FromCurrency: from,
+		ToCurrency:   base,
+		EffectiveOn:  pgtype.Date{Time: time.Now().UTC(), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgtype.Numeric{}, 0, false, nil
+		}
+		return pgtype.Numeric{}, 0, false, err
+	}
+
+	rate := exchangeRate.Rate
+	if exchangeRate.BaseCurrency != from {
+		// the rate was loaded the other way around, one unit of the base currency being worth rate units of from
+		rate = ratToNumeric(new(big.Rat).Inv(numericToRat(rate)))
+	}
+
+	return rate, currency.Convert(amount, from, base, numericToRat(rate)), true, nil
+}
+
+func missingExchangeRateMessage(from string, base string) string {
+	return fmt.Sprintf("Não há cotação de %s para %s, a moeda base da viagem. Carregue as cotações ou use %s.", from, base, base)
+}
+
+func numericToRat(numeric pgtype.Numeric) *big.Rat {
+	rat := new(big.Rat).SetInt(numeric.Int)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(max(numeric.Exp, -numeric.Exp))), nil))
+	if numeric.Exp > 0 {
+		return rat.Mul(rat, scale)
+	}
+	return rat.Quo(rat, scale)
+}
+
+// ratToNumeric rounds rat to exchangeRateScale decimal places, halves up.
+func ratToNumeric(rat *big.Rat) pgtype.Numeric {
+	scaled := new(big.Int).Mul(rat.Num(), new(big.Int).Exp(big.NewInt(10), big.NewInt(exchangeRateScale), nil))
+	scaled.Lsh(scaled, 1)
+	scaled.Add(scaled, rat.Denom())
+	scaled.Quo(scaled, new(big.Int).Lsh(rat.Denom(), 1))
+
+	return pgtype.Numeric{Int: scaled, Exp: -exchangeRateScale, Valid: true}
+}
+
+// formatExchangeRate writes the rate as a decimal number without trailing zeros, such as 5.4321.
+func formatExchangeRate(numeric pgtype.Numeric) string {
+	return strings.TrimSuffix(strings.TrimRight(numericToRat(numeric).FloatString(exchangeRateScale), "0"), ".")
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"math/big"
+	"nlw-journey/internal/currency"
+	"testing"
+)
+
+func TestNumericToRat(t *testing.T) {
+	tests := []struct {
+		numeric pgtype.Numeric
+		want    *big.Rat
+	}{
+		{pgtype.Numeric{Int: big.NewInt(54321), Exp: -4, Valid: true}, big.NewRat(54321, 10000)},
+		{pgtype.Numeric{Int: big.NewInt(5), Exp: 2, Valid: true}, big.NewRat(500, 1)},
+		{pgtype.Numeric{Int: big.NewInt(1), Exp: 0, Valid: true}, big.NewRat(1, 1)},
+	}
+
+	for _, test := range tests {
+		if got := numericToRat(test.numeric); got.Cmp(test.want) != 0 {
+			t.Errorf("numericToRat(%v) = %s, want %s", test.numeric, got, test.want)
+		}
+	}
+}
+
+func TestRatToNumeric(t *testing.T) {
+	tests := []struct {
+		rat  *big.Rat
+		want int64
+	}{
+		{big.NewRat(1, 3), 333333333333},
+		{big.NewRat(2, 3), 666666666667},
+		{big.NewRat(1, 2_000_000_000_000), 1},
+		{big.NewRat(1, 2_000_000_000_001), 0},
+		{big.NewRat(54321, 10000), 5432100000000},
+	}
+
+	for _, test := range tests {
+		got := ratToNumeric(test.rat)
+		if got.Int.Int64() != test.want || got.Exp != -exchangeRateScale || !got.Valid {
+			t.Errorf("ratToNumeric(%s) = %v, want %de-%d", test.rat, got, test.want, exchangeRateScale)
+		}
+	}
+}
+
+func TestInverseRate(t *testing.T) {
+	tests := []struct {
+		rate   string
+		from   string
+		to     string
+		amount int64
+		want   int64
+	}{
+		// one USD is worth 5.4321 BRL, so 543.21 BRL are worth 100 USD
+		{rate: "5.4321", from: "BRL", to: "USD", amount: 54321, want: 10000},
+		// one USD is worth 151.37 JPY, so 15137 JPY are worth 100 USD
+		{rate: "151.37", from: "JPY", to: "USD", amount: 15137, want: 10000},
+		// one KWD is worth 17.7 BRL, so 17.70 BRL are worth 1 KWD
+		{rate: "17.7", from: "BRL", to: "KWD", amount: 1770, want: 1000},
+	}
+
+	for _, test := range tests {
+		rate, _ := new(big.Rat).SetString(test.rate)
+		inverse := ratToNumeric(new(big.Rat).Inv(rate))
+
+		if got := currency.Convert(test.amount, test.from, test.to, numericToRat(inverse)); got != test.want {
+			t.Errorf("Convert(%d, %s, %s, 1/%s) = %d, want %d", test.amount, test.from, test.to, test.rate, got, test.want)
+		}
+
+		back := numericToRat(ratToNumeric(new(big.Rat).Inv(numericToRat(inverse))))
+		if got, want := back.FloatString(8), rate.FloatString(8); got != want {
+			t.Errorf("inverting 1/%s again = %s, want %s", test.rate, got, want)
+		}
+	}
+}
+
+func TestFormatExchangeRate(t *testing.T) {
+	tests := []struct {
+		numeric pgtype.Numeric
+		want    string
+	}{
+		{ratToNumeric(big.NewRat(54321, 10000)), "5.4321"},
+		{ratToNumeric(big.NewRat(1, 3)), "0.333333333333"},
+		{pgtype.Numeric{Int: big.NewInt(1), Valid: true}, "1"},
+	}
+
+	for _, test := range tests {
+		if got := formatExchangeRate(test.numeric); got != test.want {
+			t.Errorf("formatExchangeRate(%v) = %q, want %q", test.numeric, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/ical"
+	"nlw-journey/internal/pgstore"
+	"strings"
+	"time"
+)
+
+// calendarFeedTokenSize is the amount of random bytes in a feed token. Only its hash is stored, so a leaked database
+// doesn't give access to anyone's feed.
+const calendarFeedTokenSize = 32
+
+func hashCalendarFeedToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
+
+// PostCalendarFeed Create or rotate the calendar feed of the authenticated user.
+// (POST /calendar/feed)
+func (api API) PostCalendarFeed(_ http.ResponseWriter, r *http.Request) *spec.Response {
+	identity, ok := IdentityFromContext(r.Context())
+	if !ok {
+		return spec.PostCalendarFeedJSON401Response(spec.Error{Message: "É necessário estar autenticado."})
+	}
+
+	raw := make([]byte, calendarFeedTokenSize)
+	if _, err := rand.Read(raw); err != nil {
+		api.logger.Error("failed to generate calendar feed token", zap.Error(err))
+		return spec.PostCalendarFeedJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	if _, err := api.repository.RotateCalendarFeed(r.Context(), api.pool, identity.Email, hashCalendarFeedToken(token)); err != nil {
+		api.logger.Error("failed to rotate calendar feed", zap.Error(err), zap.String("email", identity.Email))
+		return spec.PostCalendarFeedJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostCalendarFeedJSON201Response(spec.CalendarFeed{
+		URL:   api.apiURL + "/calendar/feeds/" + token + ".ics",
+		Token: token,
+	})
+}
+
+// DeleteCalendarFeed Revoke the calendar feed of the authenticated user.
+// (DELETE /calendar/feed)
+func (api API) DeleteCalendarFeed(_ http.ResponseWriter, r *http.Request) *spec.Response {
+	identity, ok := IdentityFromContext(r.Context())
+	if !ok {
+		return spec.DeleteCalendarFeedJSON401Response(spec.Error{Message: "É necessário estar autenticado."})
+	}
+
+	if _, err := api.repository.RevokeCalendarFeeds(r.Context(), identity.Email); err != nil {
+		api.logger.Error("failed to revoke calendar feeds", zap.Error(err), zap.String("email", identity.Email))
+		return spec.DeleteCalendarFeedJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.DeleteCalendarFeedJSON204Response(nil)
+}
+
+// GetCalendarFeedsTokenIcs Subscribe to a calendar feed.
+// (GET /calendar/feeds/{token}.ics)
+func (api API) GetCalendarFeedsTokenIcs(w http.ResponseWriter, r *http.Request, token string) *spec.Response {
+	feed, err := api.repository.GetCalendarFeedByTokenHash(r.Context(), hashCalendarFeedToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetCalendarFeedsTokenIcsJSON404Response(spec.Error{Message: "Calendário não encontrado ou revogado."})
+		}
+		api.logger.Error("failed to get calendar feed", zap.Error(err))
+		return spec.GetCalendarFeedsTokenIcsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	calendar, apiErr := api.feedCalendar(r.Context(), feed.Email)
+	if apiErr != nil {
+		return spec.GetCalendarFeedsTokenIcsJSON400Response(*apiErr)
+	}
+
+	// the feed's creation is used as DTSTAMP so the same trips always render the same bytes, which keeps the ETag
+	// stable between polls
+	var body bytes.Buffer
+	if err := calendar.Encode(&body, feed.CreatedAt.Time); err != nil {
+		api.logger.Error("failed to encode calendar feed", zap.Error(err), zap.String("feedID", feed.ID.String()))
+		return spec.GetCalendarFeedsTokenIcsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	hash := sha256.Sum256(body.Bytes())
+	etag := `"` + hex.EncodeToString(hash[:]) + `"`
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+
+	if etagMatches(r.Header.Values("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := body.WriteTo(w); err != nil {
+		api.logger.Error("failed to write calendar feed", zap.Error(err), zap.String("feedID", feed.ID.String()))
+	}
+
+	return nil
+}
+
+// etagMatches tells whether any of the If-None-Match headers matches etag. Each header is a comma-separated list of
+// entity tags, or "*", and is compared weakly, as If-None-Match requires: a W/ prefix on either side is ignored.
+func etagMatches(headers []string, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+
+	for _, header := range headers {
+		for {
+			header = strings.TrimLeft(header, " \t,")
+			if header == "" {
+				break
+			}
+
+			if header[0] == '*' {
+				return true
+			}
+
+			header = strings.TrimPrefix(header, "W/")
+			if !strings.HasPrefix(header, `"`) {
+				break
+			}
+
+			end := strings.IndexByte(header[1:], '"')
+			if end == -1 {
+				break
+			}
+
+			if header[:end+2] == etag {
+				return true
+			}
+			header = header[end+2:]
+		}
+	}
+
+	return false
+}
+
+// feedCalendar builds a single calendar with every trip the user owns or takes part in.
+func (api API) feedCalendar(ctx context.Context, email string) (ical.Calendar, *spec.Error) {
+	trips, err := api.repository.GetMemberTrips(ctx, email)
+	if err != nil {
+		api.logger.Error("failed to get member trips", zap.Error(err), zap.String("email", email))
+		return ical.Calendar{}, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	tripIDs := make([]uuid.UUID, len(trips))
+	for i, trip := range trips {
+		tripIDs[i] = trip.ID
+	}
+
+	activities, err := api.repository.GetActivitiesOfTrips(ctx, tripIDs)
+	if err != nil {
+		api.logger.Error("failed to get activities of trips", zap.Error(err), zap.String("email", email))
+		return ical.Calendar{}, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	links, err := api.repository.GetLinksOfTrips(ctx, tripIDs)
+	if err != nil {
+		api.logger.Error("failed to get links of trips", zap.Error(err), zap.String("email", email))
+		return ical.Calendar{}, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	activitiesByTrip := make(map[uuid.UUID][]pgstore.Activity)
+	for _, activity := range activities {
+		activitiesByTrip[activity.TripID] = append(activitiesByTrip[activity.TripID], activity)
+	}
+
+	linksByTrip := make(map[uuid.UUID][]pgstore.Link)
+	for _, link := range links {
+		linksByTrip[link.TripID] = append(linksByTrip[link.TripID], link)
+	}
+
+	now := time.Now()
+	calendar := ical.Calendar{
+		ProductID: calendarProductID,
+		Name:      "Minhas viagens",
+	}
+	for _, trip := range trips {
+		calendar.Events = append(calendar.Events, tripEvents(trip, activitiesByTrip[trip.ID], linksByTrip[trip.ID], now)...)
+	}
+
+	return calendar, nil
+}
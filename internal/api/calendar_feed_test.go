@@ -0,0 +1,36 @@
+package api
+
+import "testing"
+
+func TestEtagMatches(t *testing.T) {
+	etag := `"abc123"`
+
+	tests := []struct {
+		name    string
+		headers []string
+		want    bool
+	}{
+		{name: "no header", want: false},
+		{name: "same tag", headers: []string{`"abc123"`}, want: true},
+		{name: "other tag", headers: []string{`"def456"`}, want: false},
+		{name: "weak tag", headers: []string{`W/"abc123"`}, want: true},
+		{name: "any tag", headers: []string{`*`}, want: true},
+		{name: "listed among others", headers: []string{`"def456", W/"abc123"`}, want: true},
+		{name: "listed without spaces", headers: []string{`"def456","abc123"`}, want: true},
+		{name: "in a repeated header", headers: []string{`"def456"`, `"abc123"`}, want: true},
+		{name: "none of the list", headers: []string{`"def456", "ghi789"`}, want: false},
+		{name: "unquoted", headers: []string{`abc123`}, want: false},
+		{name: "prefix of another tag", headers: []string{`"abc1234"`}, want: false},
+		{name: "unterminated", headers: []string{`"abc123`}, want: false},
+		{name: "weak prefix alone", headers: []string{`W/`}, want: false},
+		{name: "comma inside a tag", headers: []string{`"abc123,x", "def456"`}, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := etagMatches(test.headers, etag); got != test.want {
+				t.Errorf("etagMatches(%q, %s) = %t, want %t", test.headers, etag, got, test.want)
+			}
+		})
+	}
+}
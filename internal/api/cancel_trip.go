@@ -0,0 +1,40 @@
+package api
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"time"
+)
+
+// PostTripsTripIDCancel Cancel a trip.
+// (POST /trips/{tripId}/cancel)
+func (api API) PostTripsTripIDCancel(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PostTripsTripIDCancelJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeTrip(r.Context(), tripID, ownerAccess)
+	if apiErr != nil {
+		return spec.PostTripsTripIDCancelJSON400Response(*apiErr).Status(status)
+	}
+
+	if !canTransitionTrip(currentTripStatus(trip, time.Now()), pgstore.TripStatusCancelled) {
+		return spec.PostTripsTripIDCancelJSON400Response(spec.Error{Message: "Essa viagem não pode mais ser cancelada."})
+	}
+
+	if err := api.repository.CancelTrip(r.Context(), api.pool, trip); err != nil {
+		if errors.Is(err, pgstore.ErrTripStatusChanged) {
+			return spec.PostTripsTripIDCancelJSON400Response(spec.Error{Message: "Essa viagem não pode mais ser cancelada."})
+		}
+
+		api.logger.Error("failed to cancel trip", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.PostTripsTripIDCancelJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostTripsTripIDCancelJSON204Response(struct{}{})
+}
@@ -0,0 +1,458 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"strings"
+)
+
+// GetTripsTripIDChecklists List the trip checklists and their items.
+// (GET /trips/{tripId}/checklists)
+func (api API) GetTripsTripIDChecklists(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.GetTripsTripIDChecklistsJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTrip(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.GetTripsTripIDChecklistsJSON400Response(*apiErr).Status(status)
+	}
+
+	checklists, err := api.repository.GetTripChecklists(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's checklists", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDChecklistsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	items, err := api.repository.GetTripChecklistItems(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's checklist items", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDChecklistsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	itemsByChecklist := make(map[uuid.UUID][]spec.ChecklistItem, len(checklists))
+	for _, item := range items {
+		itemsByChecklist[item.ChecklistID] = append(itemsByChecklist[item.ChecklistID], specChecklistItem(item))
+	}
+
+	mappedChecklists := make([]spec.Checklist, len(checklists))
+	for i, checklist := range checklists {
+		mappedChecklists[i] = spec.Checklist{
+			ID:        checklist.ID.String(),
+			Title:     checklist.Title,
+			Kind:      string(checklist.Kind),
+			CreatedAt: checklist.CreatedAt.Time,
+			Items:     itemsByChecklist[checklist.ID],
+		}
+
+		if mappedChecklists[i].Items == nil {
+			mappedChecklists[i].Items = []spec.ChecklistItem{}
+		}
+	}
+
+	return spec.GetTripsTripIDChecklistsJSON200Response(spec.ListTripChecklistsResponse{Checklists: mappedChecklists})
+}
+
+// PostTripsTripIDChecklists Create a trip checklist.
+// (POST /trips/{tripId}/checklists)
+func (api API) PostTripsTripIDChecklists(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PostTripsTripIDChecklistsJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.PostTripsTripIDChecklistsJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PostTripsTripIDChecklistsJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDChecklistsJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDChecklistsJSON400Response(invalidInput(err))
+	}
+
+	checklistID, err := api.repository.InsertChecklist(r.Context(), pgstore.InsertChecklistParams{
+		TripID: tripID,
+		Title:  body.Title,
+		Kind:   pgstore.ChecklistKind(body.Kind),
+	})
+	if err != nil {
+		api.logger.Error("failed to insert checklist", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.PostTripsTripIDChecklistsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostTripsTripIDChecklistsJSON201Response(spec.CreateTripChecklistResponse{ChecklistID: checklistID.String()})
+}
+
+// PutTripsTripIDChecklistsChecklistID Update a trip checklist.
+// (PUT /trips/{tripId}/checklists/{checklistId})
+func (api API) PutTripsTripIDChecklistsChecklistID(_ http.ResponseWriter, r *http.Request, _tripID string, _checklistID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	checklistID, err := uuid.Parse(_checklistID)
+	if err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDJSON400Response(spec.Error{Message: "ID de lista inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PutTripsTripIDChecklistsChecklistIDJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDJSON400Response(invalidInput(err))
+	}
+
+	updated, err := api.repository.UpdateChecklist(r.Context(), pgstore.UpdateChecklistParams{
+		Title:  body.Title,
+		Kind:   pgstore.ChecklistKind(body.Kind),
+		ID:     checklistID,
+		TripID: tripID,
+	})
+	if err != nil {
+		api.logger.Error("failed to update checklist", zap.Error(err), zap.String("checklistID", _checklistID))
+		return spec.PutTripsTripIDChecklistsChecklistIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if updated == 0 {
+		return spec.PutTripsTripIDChecklistsChecklistIDJSON400Response(spec.Error{Message: "Lista não encontrada."})
+	}
+
+	return spec.PutTripsTripIDChecklistsChecklistIDJSON204Response(struct{}{})
+}
+
+// DeleteTripsTripIDChecklistsChecklistID Delete a trip checklist and its items.
+// (DELETE /trips/{tripId}/checklists/{checklistId})
+func (api API) DeleteTripsTripIDChecklistsChecklistID(_ http.ResponseWriter, r *http.Request, _tripID string, _checklistID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDChecklistsChecklistIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	checklistID, err := uuid.Parse(_checklistID)
+	if err != nil {
+		return spec.DeleteTripsTripIDChecklistsChecklistIDJSON400Response(spec.Error{Message: "ID de lista inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.DeleteTripsTripIDChecklistsChecklistIDJSON400Response(*apiErr).Status(status)
+	}
+
+	deleted, err := api.repository.DeleteChecklist(r.Context(), pgstore.DeleteChecklistParams{ID: checklistID, TripID: tripID})
+	if err != nil {
+		api.logger.Error("failed to delete checklist", zap.Error(err), zap.String("checklistID", _checklistID))
+		return spec.DeleteTripsTripIDChecklistsChecklistIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDChecklistsChecklistIDJSON400Response(spec.Error{Message: "Lista não encontrada."})
+	}
+
+	return spec.DeleteTripsTripIDChecklistsChecklistIDJSON204Response(struct{}{})
+}
+
+// PostTripsTripIDChecklistsChecklistIDItems Add an item to the end of a checklist.
+// (POST /trips/{tripId}/checklists/{checklistId}/items)
+func (api API) PostTripsTripIDChecklistsChecklistIDItems(_ http.ResponseWriter, r *http.Request, _tripID string, _checklistID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PostTripsTripIDChecklistsChecklistIDItemsJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	checklistID, err := uuid.Parse(_checklistID)
+	if err != nil {
+		return spec.PostTripsTripIDChecklistsChecklistIDItemsJSON400Response(spec.Error{Message: "ID de lista inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess)
+	if apiErr != nil {
+		return spec.PostTripsTripIDChecklistsChecklistIDItemsJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PostTripsTripIDChecklistsChecklistIDItemsJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDChecklistsChecklistIDItemsJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDChecklistsChecklistIDItemsJSON400Response(invalidInput(err))
+	}
+
+	if apiErr := api.checkTripChecklist(r.Context(), tripID, checklistID); apiErr != nil {
+		return spec.PostTripsTripIDChecklistsChecklistIDItemsJSON400Response(*apiErr)
+	}
+
+	item, apiErr := api.prepareChecklistItem(r.Context(), trip, spec.ChecklistItemInput(body))
+	if apiErr != nil {
+		return spec.PostTripsTripIDChecklistsChecklistIDItemsJSON400Response(*apiErr)
+	}
+	item.ChecklistID = checklistID
+
+	itemID, err := api.repository.InsertChecklistItem(r.Context(), item)
+	if err != nil {
+		api.logger.Error("failed to insert checklist item", zap.Error(err), zap.String("checklistID", _checklistID))
+		return spec.PostTripsTripIDChecklistsChecklistIDItemsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostTripsTripIDChecklistsChecklistIDItemsJSON201Response(spec.CreateChecklistItemResponse{ItemID: itemID.String()})
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemID Update a checklist item.
+// (PUT /trips/{tripId}/checklists/{checklistId}/items/{itemId})
+func (api API) PutTripsTripIDChecklistsChecklistIDItemsItemID(_ http.ResponseWriter, r *http.Request, _tripID string, _checklistID string, _itemID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	checklistID, err := uuid.Parse(_checklistID)
+	if err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(spec.Error{Message: "ID de lista inválido."})
+	}
+
+	itemID, err := uuid.Parse(_itemID)
+	if err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(spec.Error{Message: "ID de item inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess)
+	if apiErr != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(invalidInput(err))
+	}
+
+	if apiErr := api.checkTripChecklist(r.Context(), tripID, checklistID); apiErr != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(*apiErr)
+	}
+
+	item, apiErr := api.prepareChecklistItem(r.Context(), trip, spec.ChecklistItemInput(body))
+	if apiErr != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(*apiErr)
+	}
+
+	updated, err := api.repository.UpdateChecklistItem(r.Context(), pgstore.UpdateChecklistItemParams{
+		Title:         item.Title,
+		GroupName:     item.GroupName,
+		AssigneeEmail: item.AssigneeEmail,
+		IsDone:        item.IsDone,
+		ID:            itemID,
+		ChecklistID:   checklistID,
+	})
+	if err != nil {
+		api.logger.Error("failed to update checklist item", zap.Error(err), zap.String("itemID", _itemID))
+		return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if updated == 0 {
+		return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(spec.Error{Message: "Item não encontrado."})
+	}
+
+	return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response(struct{}{})
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDItemsItemID Delete a checklist item.
+// (DELETE /trips/{tripId}/checklists/{checklistId}/items/{itemId})
+func (api API) DeleteTripsTripIDChecklistsChecklistIDItemsItemID(_ http.ResponseWriter, r *http.Request, _tripID string, _checklistID string, _itemID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	checklistID, err := uuid.Parse(_checklistID)
+	if err != nil {
+		return spec.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(spec.Error{Message: "ID de lista inválido."})
+	}
+
+	itemID, err := uuid.Parse(_itemID)
+	if err != nil {
+		return spec.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(spec.Error{Message: "ID de item inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(*apiErr).Status(status)
+	}
+
+	if apiErr := api.checkTripChecklist(r.Context(), tripID, checklistID); apiErr != nil {
+		return spec.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(*apiErr)
+	}
+
+	deleted, err := api.repository.DeleteChecklistItem(r.Context(), pgstore.DeleteChecklistItemParams{ID: itemID, ChecklistID: checklistID})
+	if err != nil {
+		api.logger.Error("failed to delete checklist item", zap.Error(err), zap.String("itemID", _itemID))
+		return spec.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(spec.Error{Message: "Item não encontrado."})
+	}
+
+	return spec.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response(struct{}{})
+}
+
+// PutTripsTripIDChecklistsChecklistIDOrder Reorder the items of a checklist.
+// (PUT /trips/{tripId}/checklists/{checklistId}/order)
+func (api API) PutTripsTripIDChecklistsChecklistIDOrder(_ http.ResponseWriter, r *http.Request, _tripID string, _checklistID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDOrderJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	checklistID, err := uuid.Parse(_checklistID)
+	if err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDOrderJSON400Response(spec.Error{Message: "ID de lista inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDOrderJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PutTripsTripIDChecklistsChecklistIDOrderJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDOrderJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDOrderJSON400Response(invalidInput(err))
+	}
+
+	if apiErr := api.checkTripChecklist(r.Context(), tripID, checklistID); apiErr != nil {
+		return spec.PutTripsTripIDChecklistsChecklistIDOrderJSON400Response(*apiErr)
+	}
+
+	items, err := api.repository.GetChecklistItems(r.Context(), checklistID)
+	if err != nil {
+		api.logger.Error("failed to get checklist items", zap.Error(err), zap.String("checklistID", _checklistID))
+		return spec.PutTripsTripIDChecklistsChecklistIDOrderJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	remaining := make(map[uuid.UUID]bool, len(items))
+	for _, item := range items {
+		remaining[item.ID] = true
+	}
+
+	// every item must be listed exactly once, so unknown and repeated IDs are both refused
+	listsEveryItem := len(body.ItemIds) == len(items)
+	itemIDs := make([]uuid.UUID, len(body.ItemIds))
+	for i, _itemID := range body.ItemIds {
+		itemID := uuid.MustParse(_itemID)
+		if !remaining[itemID] {
+			listsEveryItem = false
+			break
+		}
+		delete(remaining, itemID)
+		itemIDs[i] = itemID
+	}
+
+	if !listsEveryItem {
+		return spec.PutTripsTripIDChecklistsChecklistIDOrderJSON400Response(spec.Error{
+			Message: "Input inválido.",
+			Errors: []spec.FieldError{{
+				Field:   "item_ids",
+				Message: "Deve listar cada item da lista uma única vez.",
+			}},
+		})
+	}
+
+	if err := api.repository.ReorderChecklistItems(r.Context(), api.pool, checklistID, itemIDs); err != nil {
+		api.logger.Error("failed to reorder checklist items", zap.Error(err), zap.String("checklistID", _checklistID))
+		return spec.PutTripsTripIDChecklistsChecklistIDOrderJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PutTripsTripIDChecklistsChecklistIDOrderJSON204Response(struct{}{})
+}
+
+// checkTripChecklist makes sure the checklist belongs to the trip, since item routes only filter items by checklist.
+func (api API) checkTripChecklist(ctx context.Context, tripID uuid.UUID, checklistID uuid.UUID) *spec.Error {
+	exists, err := api.repository.IsTripChecklist(ctx, pgstore.IsTripChecklistParams{ID: checklistID, TripID: tripID})
+	if err != nil {
+		api.logger.Error("failed to get checklist", zap.Error(err), zap.String("checklistID", checklistID.String()))
+		return &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	if !exists {
+		return &spec.Error{Message: "Lista não encontrada."}
+	}
+
+	return nil
+}
+
+// prepareChecklistItem checks that the item is assigned to a member of the trip. Blank groups are dropped, so the item
+// is shown outside of any group.
+func (api API) prepareChecklistItem(ctx context.Context, trip pgstore.Trip, body spec.ChecklistItemInput) (pgstore.InsertChecklistItemParams, *spec.Error) {
+	item := pgstore.InsertChecklistItemParams{
+		Title:     body.Title,
+		GroupName: stringToText(body.Group),
+		IsDone:    body.IsDone != nil && *body.IsDone,
+	}
+
+	if item.GroupName.String == "" {
+		item.GroupName = pgtype.Text{}
+	}
+
+	if body.AssigneeEmail != nil {
+		members, apiErr := api.tripMembers(ctx, trip)
+		if apiErr != nil {
+			return pgstore.InsertChecklistItemParams{}, apiErr
+		}
+
+		if !members[strings.ToLower(string(*body.AssigneeEmail))] {
+			return pgstore.InsertChecklistItemParams{}, &spec.Error{
+				Message: "Input inválido.",
+				Errors: []spec.FieldError{{
+					Field:   "assignee_email",
+					Message: "Deve ser o dono ou um participante da viagem.",
+				}},
+			}
+		}
+
+		item.AssigneeEmail = pgtype.Text{String: string(*body.AssigneeEmail), Valid: true}
+	}
+
+	return item, nil
+}
+
+func specChecklistItem(item pgstore.ChecklistItem) spec.ChecklistItem {
+	mappedItem := spec.ChecklistItem{
+		ID:       item.ID.String(),
+		Title:    item.Title,
+		Group:    textToPointer(item.GroupName),
+		IsDone:   item.IsDone,
+		Position: int(item.Position),
+	}
+
+	if item.AssigneeEmail.Valid {
+		assigneeEmail := types.Email(item.AssigneeEmail.String)
+		mappedItem.AssigneeEmail = &assigneeEmail
+	}
+
+	if item.DoneAt.Valid {
+		mappedItem.DoneAt = &item.DoneAt.Time
+	}
+
+	return mappedItem
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+)
+
+// PostTripsTripIDClone Clone a trip.
+// (POST /trips/{tripId}/clone)
+func (api API) PostTripsTripIDClone(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PostTripsTripIDCloneJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	var body spec.PostTripsTripIDCloneJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDCloneJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDCloneJSON400Response(invalidInput(err))
+	}
+
+	// cancelled and completed trips are cloned just as well, since repeating a past trip is the whole point
+	trip, status, apiErr := api.authorizeTrip(r.Context(), tripID, ownerAccess)
+	if apiErr != nil {
+		return spec.PostTripsTripIDCloneJSON400Response(*apiErr).Status(status)
+	}
+
+	inviteParticipants := body.InviteParticipants != nil && *body.InviteParticipants
+
+	cloneID, err := api.repository.CloneTrip(r.Context(), api.pool, trip, body.StartsAt, inviteParticipants)
+	if err != nil {
+		api.logger.Error("failed to clone trip", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.PostTripsTripIDCloneJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostTripsTripIDCloneJSON201Response(spec.CreateTripResponse{TripID: cloneID.String()})
+}
@@ -1,16 +1,30 @@
 package api
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 	"net/http"
 	"nlw-journey/internal/api/spec"
 	"nlw-journey/internal/pgstore"
+	"nlw-journey/internal/token"
+	"time"
 )
 
+// GetParticipantsParticipantIDConfirm Confirms a participant on a trip through the link sent on the invitation e-mail.
+// (GET /participants/{participantId}/confirm)
+func (api API) GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	return api.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+}
+
 func (api API) PatchParticipantsParticipantIDConfirm(_ http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	if !api.legacyConfirmation {
+		return spec.PatchParticipantsParticipantIDConfirmJSON410Response(spec.Error{
+			Message: "Essa rota foi desativada. Utilize o link enviado por e-mail para confirmar a sua presença.",
+		})
+	}
+
 	id, err := uuid.Parse(participantID)
 
 	if err != nil {
@@ -19,36 +33,87 @@ func (api API) PatchParticipantsParticipantIDConfirm(_ http.ResponseWriter, r *h
 		)
 	}
 
-	participant, err := api.repository.GetParticipant(r.Context(), id)
+	if apiErr := api.confirmParticipant(r.Context(), id, participantProfile{}, nil); apiErr != nil {
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(*apiErr)
+	}
+
+	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(struct{}{})
+}
+
+// GetConfirmationsParticipantsToken Show the invitation a signed confirmation link refers to, without confirming it.
+// (GET /confirmations/participants/{token})
+// Mail scanners may open the link before the participant does, so the confirmation itself only happens on POST.
+func (api API) GetConfirmationsParticipantsToken(_ http.ResponseWriter, r *http.Request, _token string) *spec.Response {
+	claims, _, apiErr := api.verifyUnusedToken(r.Context(), _token, token.PurposeConfirmParticipant)
+	if apiErr != nil {
+		return spec.GetConfirmationsParticipantsTokenJSON400Response(*apiErr)
+	}
 
+	participantID, err := uuid.Parse(claims.Subject)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-				Message: "Participante não encontrado.",
-			})
-		}
-
-		api.logger.Error("Failed to get participant", zap.Error(err), zap.String("participant's ID", participantID))
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-			Message: "Alguma coisa deu errado... Tente novamente mais tarde.",
-		})
+		return spec.GetConfirmationsParticipantsTokenJSON400Response(spec.Error{Message: "Link inválido."})
 	}
 
-	if participant.IsConfirmed {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-			Message: "Participante já confirmado.",
-		})
+	participant, apiErr := api.getParticipant(r.Context(), participantID)
+	if apiErr != nil {
+		return spec.GetConfirmationsParticipantsTokenJSON400Response(*apiErr)
 	}
 
-	if err := api.repository.ConfirmParticipant(r.Context(), participant.ID); err != nil {
-		api.logger.Error("Failed to confirm participant", zap.Error(err), zap.String("participant's ID", participantID))
+	trip, err := api.repository.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		api.logger.Error("failed to get participant's trip", zap.Error(err), zap.String("participantID", participantID.String()))
+		return spec.GetConfirmationsParticipantsTokenJSON400Response(spec.Error{Message: "Alguma coisa deu errado... Tente novamente mais tarde."})
+	}
 
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-			Message: "Alguma coisa deu errado... Tente novamente mais tarde.",
-		})
+	return spec.GetConfirmationsParticipantsTokenJSON200Response(struct {
+		Participant spec.Participant `json:"participant"`
+		Trip        spec.Trip        `json:"trip"`
+	}{
+		Participant: api.specParticipant(participant),
+		Trip:        specTrip(trip, time.Now()),
+	})
+}
+
+// PostConfirmationsParticipantsToken Confirms a participant on a trip through the signed link sent on the invitation e-mail.
+// (POST /confirmations/participants/{token})
+// The participant may also send their name and phone, which are saved along with the confirmation.
+func (api API) PostConfirmationsParticipantsToken(_ http.ResponseWriter, r *http.Request, _token string) *spec.Response {
+	var body spec.PostConfirmationsParticipantsTokenJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostConfirmationsParticipantsTokenJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	profile := participantProfile{Name: body.Name, Phone: body.Phone}
+	if err := api.validator.Struct(profile); err != nil {
+		return spec.PostConfirmationsParticipantsTokenJSON400Response(invalidInput(err))
+	}
+
+	claims, usedToken, apiErr := api.verifyUnusedToken(r.Context(), _token, token.PurposeConfirmParticipant)
+	if apiErr != nil {
+		return spec.PostConfirmationsParticipantsTokenJSON400Response(*apiErr)
+	}
+
+	participantID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return spec.PostConfirmationsParticipantsTokenJSON400Response(spec.Error{Message: "Link inválido."})
+	}
+
+	if apiErr := api.confirmParticipant(r.Context(), participantID, profile, usedToken); apiErr != nil {
+		return spec.PostConfirmationsParticipantsTokenJSON400Response(*apiErr)
+	}
+
+	return spec.PostConfirmationsParticipantsTokenJSON204Response(struct{}{})
+}
+
+func (api API) confirmParticipant(ctx context.Context, participantID uuid.UUID, profile participantProfile, usedToken *pgstore.UseTokenParams) *spec.Error {
+	participant, apiErr := api.getParticipant(ctx, participantID)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	if participant.Status == pgstore.RsvpStatusConfirmed {
+		return &spec.Error{Message: "Participante já confirmado."}
 	}
 
-	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(
-		struct{ participant pgstore.Participant }{participant},
-	)
+	return api.answerRSVP(ctx, participant, pgstore.RsvpStatusConfirmed, profile, usedToken)
 }
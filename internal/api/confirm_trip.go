@@ -1,16 +1,26 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"net/http"
 	"nlw-journey/internal/api/spec"
 	"nlw-journey/internal/pgstore"
+	"nlw-journey/internal/token"
+	"time"
 )
 
 // GetTripsTripIDConfirm Confirm a trip and send e-mail invitations.
 func (api API) GetTripsTripIDConfirm(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	if !api.legacyConfirmation {
+		return spec.GetTripsTripIDConfirmJSON410Response(spec.Error{
+			Message: "Essa rota foi desativada. Utilize o link enviado por e-mail para confirmar a viagem.",
+		})
+	}
+
 	tripID, err := uuid.Parse(_tripID)
 	if err != nil {
 		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{
@@ -18,27 +28,82 @@ func (api API) GetTripsTripIDConfirm(_ http.ResponseWriter, r *http.Request, _tr
 		})
 	}
 
+	if apiErr := api.confirmTrip(r.Context(), tripID, nil); apiErr != nil {
+		return spec.GetTripsTripIDConfirmJSON400Response(*apiErr)
+	}
+
+	return spec.GetTripsTripIDConfirmJSON204Response(struct{}{})
+}
+
+// GetConfirmationsTripsToken Show the trip a signed confirmation link refers to, without confirming it.
+// (GET /confirmations/trips/{token})
+// Mail scanners may open the link before its owner does, so the confirmation itself only happens on POST.
+func (api API) GetConfirmationsTripsToken(_ http.ResponseWriter, r *http.Request, _token string) *spec.Response {
+	claims, _, apiErr := api.verifyUnusedToken(r.Context(), _token, token.PurposeConfirmTrip)
+	if apiErr != nil {
+		return spec.GetConfirmationsTripsTokenJSON400Response(*apiErr)
+	}
+
+	tripID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return spec.GetConfirmationsTripsTokenJSON400Response(spec.Error{Message: "Link inválido."})
+	}
+
 	trip, err := api.repository.GetTrip(r.Context(), tripID)
 	if err != nil {
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: fmt.Sprintf("Não foi possível encontrar a viagem de id %s", _tripID)})
+		return spec.GetConfirmationsTripsTokenJSON400Response(spec.Error{
+			Message: fmt.Sprintf("Não foi possível encontrar a viagem de id %s", tripID.String()),
+		})
+	}
+
+	return spec.GetConfirmationsTripsTokenJSON200Response(specTrip(trip, time.Now()))
+}
+
+// PostConfirmationsTripsToken Confirm a trip through the signed link sent to its owner.
+// (POST /confirmations/trips/{token})
+func (api API) PostConfirmationsTripsToken(_ http.ResponseWriter, r *http.Request, _token string) *spec.Response {
+	claims, usedToken, apiErr := api.verifyUnusedToken(r.Context(), _token, token.PurposeConfirmTrip)
+	if apiErr != nil {
+		return spec.PostConfirmationsTripsTokenJSON400Response(*apiErr)
+	}
+
+	tripID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return spec.PostConfirmationsTripsTokenJSON400Response(spec.Error{Message: "Link inválido."})
 	}
 
-	if err := api.repository.UpdateTrip(r.Context(), pgstore.UpdateTripParams{
-		Destination: trip.Destination,
-		EndsAt:      trip.EndsAt,
-		StartsAt:    trip.StartsAt,
-		IsConfirmed: true,
-		ID:          trip.ID,
-	}); err != nil {
-		api.logger.Error("failed to update trip", zap.Error(err), zap.String("tripID", _tripID), zap.Any("trip", trip))
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "Algo deu errado agora, tente mais tarde."})
+	if apiErr := api.confirmTrip(r.Context(), tripID, usedToken); apiErr != nil {
+		return spec.PostConfirmationsTripsTokenJSON400Response(*apiErr)
+	}
+
+	return spec.PostConfirmationsTripsTokenJSON204Response(struct{}{})
+}
+
+func (api API) confirmTrip(ctx context.Context, tripID uuid.UUID, usedToken *pgstore.UseTokenParams) *spec.Error {
+	trip, err := api.repository.GetTrip(ctx, tripID)
+	if err != nil {
+		return &spec.Error{Message: fmt.Sprintf("Não foi possível encontrar a viagem de id %s", tripID.String())}
 	}
 
-	go func() {
-		if err := api.mailer.SendConfirmedTripNotificationEmail(trip); err != nil {
-			api.logger.Error("failed to send email on ConfirmTrip", zap.Error(err), zap.Any("trip", trip))
+	if !canTransitionTrip(currentTripStatus(trip, time.Now()), pgstore.TripStatusConfirmed) {
+		if trip.Status == pgstore.TripStatusConfirmed {
+			return &spec.Error{Message: "Essa viagem já foi confirmada."}
 		}
-	}()
+		return &spec.Error{Message: "Essa viagem não pode mais ser confirmada."}
+	}
 
-	return spec.GetTripsTripIDConfirmJSON204Response(struct{}{})
+	if err := api.repository.ConfirmTrip(ctx, api.pool, trip, usedToken); err != nil {
+		if errors.Is(err, pgstore.ErrTripStatusChanged) {
+			return &spec.Error{Message: "Essa viagem não pode mais ser confirmada."}
+		}
+
+		if errors.Is(err, pgstore.ErrTokenUsed) {
+			return &spec.Error{Message: "Esse link já foi utilizado."}
+		}
+
+		api.logger.Error("failed to confirm trip", zap.Error(err), zap.String("tripID", tripID.String()), zap.Any("trip", trip))
+		return &spec.Error{Message: "Algo deu errado agora, tente mais tarde."}
+	}
+
+	return nil
 }
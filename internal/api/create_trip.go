@@ -2,10 +2,10 @@ package api
 
 import (
 	"encoding/json"
-	"errors"
 	"go.uber.org/zap"
 	"net/http"
 	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
 )
 
 func (api API) PostTrips(_ http.ResponseWriter, r *http.Request) *spec.Response {
@@ -17,13 +17,21 @@ func (api API) PostTrips(_ http.ResponseWriter, r *http.Request) *spec.Response
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		errors.As(err, &err)
-		return spec.PostTripsJSON400Response(spec.Error{
-			Message: "Input inválido: " + err.Error(),
-		})
+		return spec.PostTripsJSON400Response(invalidInput(err))
 	}
 
-	tripId, err := api.repository.CreateTrip(r.Context(), api.pool, body)
+	var activities []pgstore.CreateActivityParams
+	var links []pgstore.CreateTripLinkParams
+	if body.TemplateID != nil {
+		var status int
+		var apiErr *spec.Error
+		activities, links, status, apiErr = api.materializeTemplate(r.Context(), body)
+		if apiErr != nil {
+			return spec.PostTripsJSON400Response(*apiErr).Status(status)
+		}
+	}
+
+	tripId, err := api.repository.CreateTrip(r.Context(), api.pool, body, activities, links)
 
 	if err != nil {
 		api.logger.Error("Failed to post trips", zap.Error(err), zap.Any("body", body))
@@ -32,11 +40,5 @@ func (api API) PostTrips(_ http.ResponseWriter, r *http.Request) *spec.Response
 		})
 	}
 
-	go func() {
-		if err := api.mailer.SendConfirmTripEmailToTripOwner(tripId); err != nil {
-			api.logger.Error("failed to send email on PostTrips", zap.Error(err), zap.String("tripID", tripId.String()))
-		}
-	}()
-
 	return spec.PostTripsJSON201Response(spec.CreateTripResponse{TripID: tripId.String()})
 }
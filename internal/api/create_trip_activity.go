@@ -16,21 +16,28 @@ func (api API) PostTripsTripIDActivities(_ http.ResponseWriter, r *http.Request,
 		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "Id de viagem inválido."})
 	}
 
+	trip, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess)
+	if apiErr != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(*apiErr).Status(status)
+	}
+
 	var body spec.PostTripsTripIDActivitiesJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{
-			Message: "Input inválido: " + err.Error(),
-		})
+		return spec.PostTripsTripIDActivitiesJSON400Response(invalidInput(err))
+	}
+
+	if apiErr := checkActivityDate(trip, body.OccursAt); apiErr != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(*apiErr)
 	}
 
 	activityID, err := api.repository.CreateActivity(r.Context(), pgstore.CreateActivityParams{
 		TripID: tripID,
 		Title:  body.Title,
-		OccursAt: pgtype.Timestamp{
+		OccursAt: pgtype.Timestamptz{
 			Time:  body.OccursAt,
 			Valid: true,
 		},
@@ -21,17 +21,34 @@ func (api API) PostTripsTripIDLinks(_ http.ResponseWriter, r *http.Request, _tri
 		})
 	}
 
+	if _, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(*apiErr).Status(status)
+	}
+
 	var body spec.PostTripsTripIDLinksJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
 	}
 
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(invalidInput(err))
+	}
+
+	url, err := NormalizeLinkURL(body.URL)
+	if err != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(invalidLinkURL())
+	}
+
 	linkID, err := api.repository.CreateTripLink(r.Context(), pgstore.CreateTripLinkParams{
 		TripID: tripID,
 		Title:  body.Title,
-		Url:    body.URL,
+		Url:    url,
 	})
 	if err != nil {
+		if pgstore.IsUniqueViolation(err, pgstore.LinksTripURLConstraint) {
+			return spec.PostTripsTripIDLinksJSON409Response(duplicatedLink())
+		}
+
 		api.logger.Error("failed to create trip link", zap.Error(err), zap.String("tripID", _tripID), zap.Any("body", body))
 		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "Alguma coisa deu errado. Tente mais tarde."})
 	}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+)
+
+// DeleteTripsTripID Delete a trip.
+// (DELETE /trips/{tripId})
+func (api API) DeleteTripsTripID(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTrip(r.Context(), tripID, ownerAccess); apiErr != nil {
+		return spec.DeleteTripsTripIDJSON400Response(*apiErr).Status(status)
+	}
+
+	// participants, activities and links are removed by the foreign keys' ON DELETE CASCADE
+	if _, err := api.repository.DeleteTrip(r.Context(), tripID); err != nil {
+		api.logger.Error("failed to delete trip", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.DeleteTripsTripIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.DeleteTripsTripIDJSON204Response(struct{}{})
+}
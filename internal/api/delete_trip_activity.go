@@ -0,0 +1,42 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+)
+
+// DeleteTripsTripIDActivitiesActivityID Delete a trip activity.
+// (DELETE /trips/{tripId}/activities/{activityId})
+func (api API) DeleteTripsTripIDActivitiesActivityID(_ http.ResponseWriter, r *http.Request, _tripID string, _activityID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	activityID, err := uuid.Parse(_activityID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "ID de atividade inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(*apiErr).Status(status)
+	}
+
+	deleted, err := api.repository.DeleteActivity(r.Context(), pgstore.DeleteActivityParams{
+		ID:     activityID,
+		TripID: tripID,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete activity", zap.Error(err), zap.String("tripID", _tripID), zap.String("activityID", _activityID))
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Atividade não encontrada."})
+	}
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDJSON204Response(struct{}{})
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+)
+
+// DeleteTripsTripIDLinksLinkID Delete a trip link.
+// (DELETE /trips/{tripId}/links/{linkId})
+func (api API) DeleteTripsTripIDLinksLinkID(_ http.ResponseWriter, r *http.Request, _tripID string, _linkID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	linkID, err := uuid.Parse(_linkID)
+	if err != nil {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "ID de link inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTripChange(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(*apiErr).Status(status)
+	}
+
+	deleted, err := api.repository.DeleteLink(r.Context(), pgstore.DeleteLinkParams{
+		ID:     linkID,
+		TripID: tripID,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete link", zap.Error(err), zap.String("tripID", _tripID), zap.String("linkID", _linkID))
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "Link não encontrado."})
+	}
+
+	return spec.DeleteTripsTripIDLinksLinkIDJSON204Response(struct{}{})
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"fmt"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"sort"
+)
+
+// splitExpense divides the amount between the participants the expense is split with, returning how much each one
+// owes in the same order. Amounts are integers, so the minor units that don't divide evenly are handed out one by one:
+// in the order participants are listed for equal splits, and to the largest remainders for splits by shares, ties
+// going to who is listed first.
+func splitExpense(amount int64, kind pgstore.ExpenseSplitKind, splits []spec.ExpenseSplitInput) ([]int64, []spec.FieldError) {
+	switch kind {
+	case pgstore.ExpenseSplitKindShares:
+		return splitExpenseByShares(amount, splits)
+	case pgstore.ExpenseSplitKindExact:
+		return splitExpenseByExactAmounts(amount, splits)
+	default:
+		return splitExpenseEqually(amount, splits), nil
+	}
+}
+
+func splitExpenseEqually(amount int64, splits []spec.ExpenseSplitInput) []int64 {
+	count := int64(len(splits))
+	amounts := make([]int64, len(splits))
+	for i := range amounts {
+		amounts[i] = amount / count
+		if int64(i) < amount%count {
+			amounts[i]++
+		}
+	}
+	return amounts
+}
+
+func splitExpenseByShares(amount int64, splits []spec.ExpenseSplitInput) ([]int64, []spec.FieldError) {
+	var fields []spec.FieldError
+	var totalShares int64
+	for i, split := range splits {
+		if split.Shares == nil {
+			fields = append(fields, spec.FieldError{
+				Field:   fmt.Sprintf("splits[%d].shares", i),
+				Message: "Campo obrigatório ao dividir por cotas.",
+			})
+			continue
+		}
+		totalShares += int64(*split.Shares)
+	}
+
+	if len(fields) > 0 {
+		return nil, fields
+	}
+
+	amounts := make([]int64, len(splits))
+	remainders := make([]int64, len(splits))
+	leftover := amount
+	for i, split := range splits {
+		// amounts and shares are capped by the validation, so the product can't overflow
+		amounts[i] = amount * int64(*split.Shares) / totalShares
+		remainders[i] = amount * int64(*split.Shares) % totalShares
+		leftover -= amounts[i]
+	}
+
+	order := make([]int, len(splits))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+
+	for _, i := range order[:leftover] {
+		amounts[i]++
+	}
+
+	return amounts, nil
+}
+
+func splitExpenseByExactAmounts(amount int64, splits []spec.ExpenseSplitInput) ([]int64, []spec.FieldError) {
+	var fields []spec.FieldError
+	var total int64
+	amounts := make([]int64, len(splits))
+	for i, split := range splits {
+		if split.Amount == nil {
+			fields = append(fields, spec.FieldError{
+				Field:   fmt.Sprintf("splits[%d].amount", i),
+				Message: "Campo obrigatório ao dividir por valores exatos.",
+			})
+			continue
+		}
+		amounts[i] = *split.Amount
+		total += *split.Amount
+	}
+
+	if len(fields) > 0 {
+		return nil, fields
+	}
+
+	if total != amount {
+		return nil, []spec.FieldError{{
+			Field:   "splits",
+			Message: fmt.Sprintf("A soma das partes (%d) deve ser igual ao valor da despesa (%d).", total, amount),
+		}}
+	}
+
+	return amounts, nil
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"reflect"
+	"testing"
+)
+
+func sharesSplits(shares ...int) []spec.ExpenseSplitInput {
+	splits := make([]spec.ExpenseSplitInput, len(shares))
+	for i := range shares {
+		splits[i].Shares = &shares[i]
+	}
+	return splits
+}
+
+func exactSplits(amounts ...int64) []spec.ExpenseSplitInput {
+	splits := make([]spec.ExpenseSplitInput, len(amounts))
+	for i := range amounts {
+		splits[i].Amount = &amounts[i]
+	}
+	return splits
+}
+
+func TestSplitExpense(t *testing.T) {
+	tests := []struct {
+		name       string
+		amount     int64
+		kind       pgstore.ExpenseSplitKind
+		splits     []spec.ExpenseSplitInput
+		want       []int64
+		wantFields []spec.FieldError
+	}{
+		{
+			name:   "equal split hands the leftover to who is listed first",
+			amount: 100,
+			kind:   pgstore.ExpenseSplitKindEqual,
+			splits: make([]spec.ExpenseSplitInput, 3),
+			want:   []int64{34, 33, 33},
+		},
+		{
+			name:   "equal split of less than one unit each",
+			amount: 2,
+			kind:   pgstore.ExpenseSplitKindEqual,
+			splits: make([]spec.ExpenseSplitInput, 3),
+			want:   []int64{1, 1, 0},
+		},
+		{
+			name:   "shares hand the leftover to the largest remainders",
+			amount: 1000,
+			kind:   pgstore.ExpenseSplitKindShares,
+			splits: sharesSplits(1, 2, 4),
+			want:   []int64{143, 286, 571},
+		},
+		{
+			name:   "shares with tied remainders favor who is listed first",
+			amount: 100,
+			kind:   pgstore.ExpenseSplitKindShares,
+			splits: sharesSplits(1, 1, 1),
+			want:   []int64{34, 33, 33},
+		},
+		{
+			name:       "shares must be given for everyone",
+			amount:     100,
+			kind:       pgstore.ExpenseSplitKindShares,
+			splits:     []spec.ExpenseSplitInput{sharesSplits(1)[0], {}},
+			wantFields: []spec.FieldError{{Field: "splits[1].shares", Message: "Campo obrigatório ao dividir por cotas."}},
+		},
+		{
+			name:   "exact amounts adding up to the expense",
+			amount: 100,
+			kind:   pgstore.ExpenseSplitKindExact,
+			splits: exactSplits(60, 0, 40),
+			want:   []int64{60, 0, 40},
+		},
+		{
+			name:   "exact amounts adding up to less than the expense",
+			amount: 100,
+			kind:   pgstore.ExpenseSplitKindExact,
+			splits: exactSplits(60, 30),
+			wantFields: []spec.FieldError{{
+				Field:   "splits",
+				Message: "A soma das partes (90) deve ser igual ao valor da despesa (100).",
+			}},
+		},
+		{
+			name:   "exact amounts adding up to more than the expense",
+			amount: 100,
+			kind:   pgstore.ExpenseSplitKindExact,
+			splits: exactSplits(60, 41),
+			wantFields: []spec.FieldError{{
+				Field:   "splits",
+				Message: "A soma das partes (101) deve ser igual ao valor da despesa (100).",
+			}},
+		},
+		{
+			name:       "exact amounts must be given for everyone",
+			amount:     100,
+			kind:       pgstore.ExpenseSplitKindExact,
+			splits:     []spec.ExpenseSplitInput{{}, exactSplits(100)[0]},
+			wantFields: []spec.FieldError{{Field: "splits[0].amount", Message: "Campo obrigatório ao dividir por valores exatos."}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, fields := splitExpense(test.amount, test.kind, test.splits)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("splitExpense() = %v, want %v", got, test.want)
+			}
+			if !reflect.DeepEqual(fields, test.wantFields) {
+				t.Errorf("splitExpense() fields = %v, want %v", fields, test.wantFields)
+			}
+		})
+	}
+}
+
+func TestSplitExpenseAddsUpToTheAmount(t *testing.T) {
+	shares := [][]int{{1}, {1, 1}, {3, 7}, {1, 2, 4}, {5, 5, 5, 5, 5, 5, 5}, {1000, 1, 999}}
+	amounts := []int64{0, 1, 7, 99, 1001, 123457, 1000000000000}
+
+	for _, amount := range amounts {
+		for _, values := range shares {
+			for _, kind := range []pgstore.ExpenseSplitKind{pgstore.ExpenseSplitKindEqual, pgstore.ExpenseSplitKindShares} {
+				split, fields := splitExpense(amount, kind, sharesSplits(values...))
+				if fields != nil {
+					t.Fatalf("splitExpense(%d, %s, %v) fields = %v", amount, kind, values, fields)
+				}
+
+				var total int64
+				for _, part := range split {
+					total += part
+				}
+				if total != amount {
+					t.Errorf("splitExpense(%d, %s, %v) = %v, adding up to %d", amount, kind, values, split, total)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,416 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/currency"
+	"nlw-journey/internal/pgstore"
+	"strings"
+	"time"
+)
+
+// GetTripsTripIDExpenses List the trip expenses.
+// (GET /trips/{tripId}/expenses)
+func (api API) GetTripsTripIDExpenses(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.GetTripsTripIDExpensesJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTrip(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.GetTripsTripIDExpensesJSON400Response(*apiErr).Status(status)
+	}
+
+	expenses, err := api.repository.GetTripExpenses(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's expenses", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDExpensesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	splits, err := api.repository.GetTripExpenseSplits(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's expense splits", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDExpensesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	splitsByExpense := make(map[uuid.UUID][]pgstore.ExpenseSplit)
+	for _, split := range splits {
+		splitsByExpense[split.ExpenseID] = append(splitsByExpense[split.ExpenseID], split)
+	}
+
+	mappedExpenses := make([]spec.Expense, len(expenses))
+	for i, expense := range expenses {
+		mappedExpenses[i] = specExpense(expense, splitsByExpense[expense.ID])
+	}
+
+	return spec.GetTripsTripIDExpensesJSON200Response(spec.ListTripExpensesResponse{Expenses: mappedExpenses})
+}
+
+// PostTripsTripIDExpenses Add an expense to the trip.
+// (POST /trips/{tripId}/expenses)
+func (api API) PostTripsTripIDExpenses(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PostTripsTripIDExpensesJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeExpenseChange(r.Context(), tripID, memberAccess)
+	if apiErr != nil {
+		return spec.PostTripsTripIDExpensesJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PostTripsTripIDExpensesJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDExpensesJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDExpensesJSON400Response(invalidInput(err))
+	}
+
+	expense, splits, apiErr := api.prepareExpense(r.Context(), trip, spec.ExpenseInput(body), nil)
+	if apiErr != nil {
+		return spec.PostTripsTripIDExpensesJSON400Response(*apiErr)
+	}
+
+	expenseID, err := api.repository.CreateExpense(r.Context(), api.pool, expense, splits)
+	if err != nil {
+		api.logger.Error("failed to create expense", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.PostTripsTripIDExpensesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostTripsTripIDExpensesJSON201Response(spec.CreateTripExpenseResponse{ExpenseID: expenseID.String()})
+}
+
+// GetTripsTripIDExpensesExpenseID Get a trip expense.
+// (GET /trips/{tripId}/expenses/{expenseId})
+func (api API) GetTripsTripIDExpensesExpenseID(_ http.ResponseWriter, r *http.Request, _tripID string, _expenseID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.GetTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	expenseID, err := uuid.Parse(_expenseID)
+	if err != nil {
+		return spec.GetTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "ID de despesa inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTrip(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.GetTripsTripIDExpensesExpenseIDJSON400Response(*apiErr).Status(status)
+	}
+
+	// the trip is part of the filter, so expenses of other trips are reported as not found
+	expense, err := api.repository.GetExpense(r.Context(), pgstore.GetExpenseParams{ID: expenseID, TripID: tripID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Despesa não encontrada."})
+		}
+
+		api.logger.Error("failed to get expense", zap.Error(err), zap.String("expenseID", _expenseID))
+		return spec.GetTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	splits, err := api.repository.GetExpenseSplits(r.Context(), expenseID)
+	if err != nil {
+		api.logger.Error("failed to get expense's splits", zap.Error(err), zap.String("expenseID", _expenseID))
+		return spec.GetTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.GetTripsTripIDExpensesExpenseIDJSON200Response(specExpense(expense, splits))
+}
+
+// PutTripsTripIDExpensesExpenseID Replace a trip expense.
+// (PUT /trips/{tripId}/expenses/{expenseId})
+func (api API) PutTripsTripIDExpensesExpenseID(_ http.ResponseWriter, r *http.Request, _tripID string, _expenseID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	expenseID, err := uuid.Parse(_expenseID)
+	if err != nil {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "ID de despesa inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeExpenseChange(r.Context(), tripID, memberAccess)
+	if apiErr != nil {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PutTripsTripIDExpensesExpenseIDJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(invalidInput(err))
+	}
+
+	previous, err := api.repository.GetExpense(r.Context(), pgstore.GetExpenseParams{ID: expenseID, TripID: tripID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Despesa não encontrada."})
+		}
+
+		api.logger.Error("failed to get expense", zap.Error(err), zap.String("expenseID", _expenseID))
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	expense, splits, apiErr := api.prepareExpense(r.Context(), trip, spec.ExpenseInput(body), &previous)
+	if apiErr != nil {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(*apiErr)
+	}
+
+	replaced, err := api.repository.ReplaceExpense(r.Context(), api.pool, pgstore.UpdateExpenseParams{
+		ActivityID:   expense.ActivityID,
+		PayerEmail:   expense.PayerEmail,
+		Description:  expense.Description,
+		Amount:       expense.Amount,
+		Currency:     expense.Currency,
+		SplitKind:    expense.SplitKind,
+		Category:     expense.Category,
+		BaseCurrency: expense.BaseCurrency,
+		ExchangeRate: expense.ExchangeRate,
+		BaseAmount:   expense.BaseAmount,
+		ID:           expenseID,
+		TripID:       tripID,
+	}, splits)
+	if err != nil {
+		api.logger.Error("failed to replace expense", zap.Error(err), zap.String("expenseID", _expenseID))
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if !replaced {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Despesa não encontrada."})
+	}
+
+	return spec.PutTripsTripIDExpensesExpenseIDJSON204Response(struct{}{})
+}
+
+// DeleteTripsTripIDExpensesExpenseID Delete a trip expense.
+// (DELETE /trips/{tripId}/expenses/{expenseId})
+func (api API) DeleteTripsTripIDExpensesExpenseID(_ http.ResponseWriter, r *http.Request, _tripID string, _expenseID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	expenseID, err := uuid.Parse(_expenseID)
+	if err != nil {
+		return spec.DeleteTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "ID de despesa inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeExpenseChange(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.DeleteTripsTripIDExpensesExpenseIDJSON400Response(*apiErr).Status(status)
+	}
+
+	// splits are removed by the foreign key's ON DELETE CASCADE
+	deleted, err := api.repository.DeleteExpense(r.Context(), pgstore.DeleteExpenseParams{ID: expenseID, TripID: tripID})
+	if err != nil {
+		api.logger.Error("failed to delete expense", zap.Error(err), zap.String("expenseID", _expenseID))
+		return spec.DeleteTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Despesa não encontrada."})
+	}
+
+	return spec.DeleteTripsTripIDExpensesExpenseIDJSON204Response(struct{}{})
+}
+
+// authorizeExpenseChange is authorizeTrip for routes that modify the trip's expenses and budgets. Unlike other changes,
+// they are still accepted once the trip is completed, since that's usually when the group settles up.
+func (api API) authorizeExpenseChange(ctx context.Context, tripID uuid.UUID, access tripAccess) (pgstore.Trip, int, *spec.Error) {
+	trip, status, apiErr := api.authorizeTrip(ctx, tripID, access)
+	if apiErr != nil {
+		return trip, status, apiErr
+	}
+
+	if currentTripStatus(trip, time.Now()) == pgstore.TripStatusCancelled {
+		return trip, http.StatusBadRequest, &spec.Error{Message: "Essa viagem foi cancelada e não pode mais ser alterada."}
+	}
+
+	return trip, http.StatusOK, nil
+}
+
+// prepareExpense checks that the expense only involves members of the trip, works out how much each participant owes
+// of it and converts it to the trip's base currency. When an expense is replaced, the rate it was converted with is
+// kept as long as its currency stays the same.
+func (api API) prepareExpense(ctx context.Context, trip pgstore.Trip, body spec.ExpenseInput, previous *pgstore.Expense) (pgstore.InsertExpenseParams, []pgstore.InsertExpenseSplitParams, *spec.Error) {
+	members, apiErr := api.tripMembers(ctx, trip)
+	if apiErr != nil {
+		return pgstore.InsertExpenseParams{}, nil, apiErr
+	}
+
+	var fields []spec.FieldError
+	if !members[strings.ToLower(string(body.PayerEmail))] {
+		fields = append(fields, spec.FieldError{
+			Field:   "payer_email",
+			Message: "Quem pagou deve ser o dono ou um participante da viagem.",
+		})
+	}
+
+	seen := make(map[string]int, len(body.Splits))
+	for i, split := range body.Splits {
+		key := strings.ToLower(string(split.Email))
+		if first, ok := seen[key]; ok {
+			fields = append(fields, spec.FieldError{
+				Field:   fmt.Sprintf("splits[%d].email", i),
+				Message: fmt.Sprintf("Participante repetido, já listado em splits[%d].", first),
+			})
+			continue
+		}
+		seen[key] = i
+
+		if !members[key] {
+			fields = append(fields, spec.FieldError{
+				Field:   fmt.Sprintf("splits[%d].email", i),
+				Message: "Deve ser o dono ou um participante da viagem.",
+			})
+		}
+	}
+
+	var activityID pgtype.UUID
+	if body.ActivityID != nil {
+		// the id is validated as a uuid before getting here
+		activityID = pgtype.UUID{Bytes: uuid.MustParse(*body.ActivityID), Valid: true}
+
+		isTripActivity, err := api.repository.IsTripActivity(ctx, pgstore.IsTripActivityParams{
+			ID:     activityID.Bytes,
+			TripID: trip.ID,
+		})
+		if err != nil {
+			api.logger.Error("failed to check trip activity", zap.Error(err), zap.String("tripID", trip.ID.String()))
+			return pgstore.InsertExpenseParams{}, nil, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+		}
+
+		if !isTripActivity {
+			fields = append(fields, spec.FieldError{Field: "activity_id", Message: "Atividade não encontrada nessa viagem."})
+		}
+	}
+
+	kind := pgstore.ExpenseSplitKind(body.SplitKind)
+	amounts, splitFields := splitExpense(body.Amount, kind, body.Splits)
+	fields = append(fields, splitFields...)
+
+	var rate pgtype.Numeric
+	var baseAmount int64
+	if previous != nil && previous.Currency == body.Currency && previous.ExchangeRate.Valid && previous.BaseCurrency.String == trip.BaseCurrency {
+		rate = previous.ExchangeRate
+		baseAmount = currency.Convert(body.Amount, body.Currency, trip.BaseCurrency, numericToRat(rate))
+	} else {
+		var found bool
+		var err error
+		rate, baseAmount, found, err = api.toBaseCurrency(ctx, body.Amount, body.Currency, trip.BaseCurrency)
+		if err != nil {
+			api.logger.Error("failed to get exchange rate", zap.Error(err), zap.String("tripID", trip.ID.String()))
+			return pgstore.InsertExpenseParams{}, nil, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+		}
+
+		if !found {
+			fields = append(fields, spec.FieldError{Field: "currency", Message: missingExchangeRateMessage(body.Currency, trip.BaseCurrency)})
+		}
+	}
+
+	if len(fields) > 0 {
+		return pgstore.InsertExpenseParams{}, nil, &spec.Error{Message: "Input inválido.", Errors: fields}
+	}
+
+	splits := make([]pgstore.InsertExpenseSplitParams, len(body.Splits))
+	for i, split := range body.Splits {
+		splits[i] = pgstore.InsertExpenseSplitParams{
+			Email:  string(split.Email),
+			Amount: amounts[i],
+		}
+		if kind == pgstore.ExpenseSplitKindShares {
+			splits[i].Shares = pgtype.Int4{Int32: int32(*split.Shares), Valid: true}
+		}
+	}
+
+	category := defaultExpenseCategory
+	if body.Category != nil {
+		category = *body.Category
+	}
+
+	return pgstore.InsertExpenseParams{
+		TripID:       trip.ID,
+		ActivityID:   activityID,
+		PayerEmail:   string(body.PayerEmail),
+		Description:  strings.TrimSpace(body.Description),
+		Amount:       body.Amount,
+		Currency:     body.Currency,
+		SplitKind:    kind,
+		Category:     category,
+		BaseCurrency: pgtype.Text{String: trip.BaseCurrency, Valid: true},
+		ExchangeRate: rate,
+		BaseAmount:   pgtype.Int8{Int64: baseAmount, Valid: true},
+	}, splits, nil
+}
+
+// tripMembers returns the lowercased e-mails of who may pay for or share the trip's expenses and be assigned its
+// checklist items: the owner and every participant that didn't decline the invitation.
+func (api API) tripMembers(ctx context.Context, trip pgstore.Trip) (map[string]bool, *spec.Error) {
+	participants, err := api.repository.GetParticipants(ctx, trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip's participants", zap.Error(err), zap.String("tripID", trip.ID.String()))
+		return nil, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	members := map[string]bool{strings.ToLower(trip.OwnerEmail): true}
+	for _, participant := range participants {
+		if participant.Status != pgstore.RsvpStatusDeclined {
+			members[strings.ToLower(participant.Email)] = true
+		}
+	}
+
+	return members, nil
+}
+
+func specExpense(expense pgstore.Expense, splits []pgstore.ExpenseSplit) spec.Expense {
+	mappedExpense := spec.Expense{
+		ID:          expense.ID.String(),
+		Description: expense.Description,
+		Amount:      expense.Amount,
+		Currency:    expense.Currency,
+		PayerEmail:  types.Email(expense.PayerEmail),
+		SplitKind:   string(expense.SplitKind),
+		Category:    expense.Category,
+		Splits:      make([]spec.ExpenseSplit, len(splits)),
+		CreatedAt:   expense.CreatedAt.Time,
+		UpdatedAt:   expense.UpdatedAt.Time,
+	}
+
+	if expense.ActivityID.Valid {
+		activityID := uuid.UUID(expense.ActivityID.Bytes).String()
+		mappedExpense.ActivityID = &activityID
+	}
+
+	if expense.BaseAmount.Valid {
+		exchangeRate := formatExchangeRate(expense.ExchangeRate)
+		mappedExpense.BaseCurrency = &expense.BaseCurrency.String
+		mappedExpense.ExchangeRate = &exchangeRate
+		mappedExpense.BaseAmount = &expense.BaseAmount.Int64
+	}
+
+	for i, split := range splits {
+		mappedExpense.Splits[i] = spec.ExpenseSplit{
+			Email:  types.Email(split.Email),
+			Amount: split.Amount,
+		}
+		if split.Shares.Valid {
+			shares := int(split.Shares.Int32)
+			mappedExpense.Splits[i].Shares = &shares
+		}
+	}
+
+	return mappedExpense
+}
@@ -1,33 +1,110 @@
 package api
 
 import (
+	"github.com/discord-gophers/goapi-gen/types"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 	"net/http"
 	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"time"
 )
 
-func (api API) GetTripsTripIDActivities(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+func (api API) GetTripsTripIDActivities(_ http.ResponseWriter, r *http.Request, _tripID string, params spec.GetTripsTripIDActivitiesParams) *spec.Response {
 	tripID, err := uuid.Parse(_tripID)
 	if err != nil {
 		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "Id de viagem inválido."})
 	}
 
+	trip, status, apiErr := api.authorizeTrip(r.Context(), tripID, memberAccess)
+	if apiErr != nil {
+		return spec.GetTripsTripIDActivitiesJSON400Response(*apiErr).Status(status)
+	}
+
 	activities, err := api.repository.GetTripActivities(r.Context(), tripID)
 	if err != nil {
+		api.logger.Error("failed to get trip's activities", zap.Error(err), zap.String("tripID", _tripID))
 		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
 	}
 
+	location := tripLocation(trip)
 	parsedActivities := make([]spec.GetTripActivitiesInner, len(activities))
 
 	for i, activity := range activities {
-		parsedActivities[i] = spec.GetTripActivitiesInner{
-			ID:       activity.ID.String(),
-			OccursAt: activity.OccursAt.Time,
-			Title:    activity.Title,
-		}
+		parsedActivities[i] = specActivity(activity, location)
+	}
+
+	identity, _ := IdentityFromContext(r.Context())
+	proposals, apiErr := api.tripActivityProposals(r.Context(), trip, identity.Email)
+	if apiErr != nil {
+		return spec.GetTripsTripIDActivitiesJSON400Response(*apiErr)
 	}
 
-	return spec.GetTripsTripIDActivitiesJSON200Response(spec.GetTripActivitiesResponse{
+	response := spec.GetTripActivitiesResponse{
 		Activities: parsedActivities,
-	})
+		Proposals:  proposals,
+	}
+
+	if params.Group != nil && *params.Group == "day" {
+		response.Days = groupActivitiesByDay(trip, activities, time.Now())
+	}
+
+	return spec.GetTripsTripIDActivitiesJSON200Response(response)
+}
+
+// specActivity converts an activity to its API representation, with its time both in UTC and in the trip's time zone.
+func specActivity(activity pgstore.Activity, location *time.Location) spec.GetTripActivitiesInner {
+	return spec.GetTripActivitiesInner{
+		ID:            activity.ID.String(),
+		OccursAt:      activity.OccursAt.Time.UTC(),
+		OccursAtLocal: activity.OccursAt.Time.In(location),
+		Title:         activity.Title,
+	}
+}
+
+// groupActivitiesByDay returns one entry per calendar day, in the trip's time zone, from the trip's start to its end,
+// each holding the activities of that day. Activities are expected to be sorted by occurs_at. The range is widened
+// when an activity falls outside of the trip dates, so that no activity is left out.
+func groupActivitiesByDay(trip pgstore.Trip, activities []pgstore.Activity, now time.Time) []spec.GetTripActivitiesDay {
+	location := tripLocation(trip)
+	localDay := func(t time.Time) time.Time {
+		return truncateToDay(t.In(location))
+	}
+
+	first := localDay(trip.StartsAt.Time)
+	last := localDay(trip.EndsAt.Time)
+
+	if len(activities) > 0 {
+		if day := localDay(activities[0].OccursAt.Time); day.Before(first) {
+			first = day
+		}
+		if day := localDay(activities[len(activities)-1].OccursAt.Time); day.After(last) {
+			last = day
+		}
+	}
+
+	today := localDay(now)
+
+	var days []spec.GetTripActivitiesDay
+	next := 0
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		dayActivities := make([]spec.GetTripActivitiesInner, 0)
+
+		for ; next < len(activities) && localDay(activities[next].OccursAt.Time).Equal(day); next++ {
+			dayActivities = append(dayActivities, specActivity(activities[next], location))
+		}
+
+		days = append(days, spec.GetTripActivitiesDay{
+			Date:       types.Date{Time: day},
+			IsPast:     day.Before(today),
+			Activities: dayActivities,
+		})
+	}
+
+	return days
+}
+
+// truncateToDay drops the time of day, keeping only the calendar date.
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 }
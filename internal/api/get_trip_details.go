@@ -4,6 +4,8 @@ import (
 	"github.com/google/uuid"
 	"net/http"
 	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"time"
 )
 
 func (api API) GetTripsTripID(_ http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
@@ -14,21 +16,37 @@ func (api API) GetTripsTripID(_ http.ResponseWriter, r *http.Request, tripID str
 		})
 	}
 
-	trip, err := api.repository.GetTrip(r.Context(), parsedTripID)
-	if err != nil {
-		return spec.GetTripsTripIDJSON400Response(spec.Error{
-			Message: "Viagem não encontrada.",
-		})
+	trip, status, apiErr := api.authorizeTrip(r.Context(), parsedTripID, memberAccess)
+	if apiErr != nil {
+		return spec.GetTripsTripIDJSON400Response(*apiErr).Status(status)
+	}
+
+	budget, apiErr := api.tripBudgetSummary(r.Context(), trip)
+	if apiErr != nil {
+		return spec.GetTripsTripIDJSON400Response(*apiErr)
 	}
+
 	return spec.GetTripsTripIDJSON200Response(struct {
-		Trip spec.Trip `json:"trip"`
+		Budget spec.TripBudgetSummary `json:"budget"`
+		Trip   spec.Trip              `json:"trip"`
 	}{
-		Trip: spec.Trip{
-			Destination: trip.Destination,
-			EndsAt:      trip.EndsAt.Time,
-			ID:          trip.ID.String(),
-			IsConfirmed: trip.IsConfirmed,
-			StartsAt:    trip.StartsAt.Time,
-		},
+		Budget: budget,
+		Trip:   specTrip(trip, time.Now()),
 	})
 }
+
+// specTrip converts a trip to its API representation, with its status as of now.
+func specTrip(trip pgstore.Trip, now time.Time) spec.Trip {
+	tripStatus := currentTripStatus(trip, now)
+
+	return spec.Trip{
+		BaseCurrency: trip.BaseCurrency,
+		Destination:  trip.Destination,
+		EndsAt:       trip.EndsAt.Time.UTC(),
+		ID:           trip.ID.String(),
+		IsConfirmed:  tripStatus == pgstore.TripStatusConfirmed || tripStatus == pgstore.TripStatusCompleted,
+		StartsAt:     trip.StartsAt.Time.UTC(),
+		Status:       specTripStatus(tripStatus),
+		TimeZone:     trip.TimeZone,
+	}
+}
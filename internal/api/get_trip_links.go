@@ -5,6 +5,8 @@ import (
 	"go.uber.org/zap"
 	"net/http"
 	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"time"
 )
 
 func (api API) GetTripsTripIDLinks(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
@@ -13,6 +15,10 @@ func (api API) GetTripsTripIDLinks(_ http.ResponseWriter, r *http.Request, _trip
 		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "Id de viagem inválido."})
 	}
 
+	if _, status, apiErr := api.authorizeTrip(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.GetTripsTripIDLinksJSON400Response(*apiErr).Status(status)
+	}
+
 	links, err := api.repository.GetTripLinks(r.Context(), tripID)
 	if err != nil {
 		api.logger.Error("failed to fetch trip links", zap.Error(err))
@@ -21,12 +27,26 @@ func (api API) GetTripsTripIDLinks(_ http.ResponseWriter, r *http.Request, _trip
 
 	parsedLinks := make([]spec.Link, len(links))
 	for i, link := range links {
-		parsedLinks[i] = spec.Link{
-			ID:    link.ID.String(),
-			Title: link.Title,
-			URL:   link.Url,
-		}
+		parsedLinks[i] = specLink(link)
 	}
 
 	return spec.GetTripsTripIDLinksJSON200Response(spec.GetTripLinksResponse{Links: parsedLinks})
 }
+
+func specLink(link pgstore.Link) spec.Link {
+	var unfurledAt *time.Time
+	if link.UnfurledAt.Valid {
+		unfurledAt = &link.UnfurledAt.Time
+	}
+
+	return spec.Link{
+		ID:                 link.ID.String(),
+		Title:              link.Title,
+		URL:                link.Url,
+		PreviewTitle:       textToPointer(link.PreviewTitle),
+		PreviewDescription: textToPointer(link.PreviewDescription),
+		PreviewImageURL:    textToPointer(link.PreviewImageUrl),
+		FaviconURL:         textToPointer(link.FaviconUrl),
+		UnfurledAt:         unfurledAt,
+	}
+}
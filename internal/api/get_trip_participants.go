@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 	"net/http"
 	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
 )
 
 func (api API) GetTripsTripIDParticipants(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
@@ -14,6 +15,10 @@ func (api API) GetTripsTripIDParticipants(_ http.ResponseWriter, r *http.Request
 		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "ID de viagem inválido."})
 	}
 
+	if _, status, apiErr := api.authorizeTrip(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.GetTripsTripIDParticipantsJSON400Response(*apiErr).Status(status)
+	}
+
 	participants, err := api.repository.GetParticipants(r.Context(), tripID)
 	if err != nil {
 		api.logger.Error("failed to get trip's participants", zap.Error(err), zap.String("tripID", _tripID))
@@ -22,12 +27,7 @@ func (api API) GetTripsTripIDParticipants(_ http.ResponseWriter, r *http.Request
 
 	mappedParticipants := make([]spec.Participant, len(participants))
 	for i, participant := range participants {
-		mappedParticipants[i] = spec.Participant{
-			Email:       types.Email(participant.Email),
-			ID:          participant.ID.String(),
-			IsConfirmed: participant.IsConfirmed,
-			Name:        nil,
-		}
+		mappedParticipants[i] = api.specParticipant(participant)
 	}
 
 	return spec.GetTripsTripIDParticipantsJSON200Response(struct {
@@ -36,3 +36,23 @@ func (api API) GetTripsTripIDParticipants(_ http.ResponseWriter, r *http.Request
 		Participants: mappedParticipants,
 	})
 }
+
+func (api API) specParticipant(participant pgstore.Participant) spec.Participant {
+	var status spec.ParticipantStatus
+	if err := status.FromValue(string(participant.Status)); err != nil {
+		api.logger.Error("unknown participant status", zap.Error(err), zap.String("participantID", participant.ID.String()))
+	}
+
+	return spec.Participant{
+		Email:       types.Email(participant.Email),
+		ID:          participant.ID.String(),
+		IsConfirmed: participant.Status == pgstore.RsvpStatusConfirmed,
+		Name:        textToPointer(participant.Name),
+		Phone:       textToPointer(participant.Phone),
+		Status:      status,
+		InvitedAt:   participant.InvitedAt.Time,
+		ConfirmedAt: timestampToPointer(participant.ConfirmedAt),
+		DeclinedAt:  timestampToPointer(participant.DeclinedAt),
+		MaybeAt:     timestampToPointer(participant.MaybeAt),
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"go.uber.org/zap"
 	"net/http"
 	"nlw-journey/internal/api/spec"
-	"nlw-journey/internal/pgstore"
 )
 
 // PostTripsTripIDInvites Invite someone to the trip.
@@ -23,18 +22,15 @@ func (api API) PostTripsTripIDInvites(_ http.ResponseWriter, r *http.Request, _t
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "Input inválido: " + err.Error()})
+		return spec.PostTripsTripIDInvitesJSON400Response(invalidInput(err))
 	}
 
-	_, err = api.repository.InviteParticipantsToTrip(r.Context(),
-		[]pgstore.InviteParticipantsToTripParams{
-			{
-				TripID: tripID,
-				Email:  string(body.Email),
-			},
-		})
+	trip, status, apiErr := api.authorizeTripChange(r.Context(), tripID, ownerAccess)
+	if apiErr != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(*apiErr).Status(status)
+	}
 
-	if err != nil {
+	if _, err := api.repository.InviteParticipant(r.Context(), api.pool, trip, string(body.Email)); err != nil {
 		api.logger.Error("failed to add participant to trip", zap.Error(err), zap.String("email", string(body.Email)), zap.String("tripID", _tripID))
 		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "Algo deu errado enquanto convidávamos o usuário. Tente novamente mais tarde."})
 	}
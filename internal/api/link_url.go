@@ -0,0 +1,133 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"nlw-journey/internal/api/spec"
+	"strings"
+)
+
+// maxLinkURLLength matches the size of the links.url column.
+const maxLinkURLLength = 2048
+
+var errUnsupportedLinkURL = errors.New("only absolute http and https urls are supported")
+
+// trackingParameters are query parameters added by ads and newsletters to track where visitors come from. They don't
+// change the page, so they are removed before links are saved. Parameters starting with utm_ are removed as well.
+var trackingParameters = map[string]bool{
+	"fbclid":    true,
+	"gclid":     true,
+	"gbraid":    true,
+	"wbraid":    true,
+	"dclid":     true,
+	"msclkid":   true,
+	"yclid":     true,
+	"twclid":    true,
+	"ttclid":    true,
+	"igshid":    true,
+	"li_fat_id": true,
+	"mc_cid":    true,
+	"mc_eid":    true,
+	"_ga":       true,
+	"_gl":       true,
+}
+
+// isWebURL tells whether raw is an absolute http or https url. Other schemes, such as javascript: and file:, must never
+// end up in links that are shown to other participants.
+func isWebURL(raw string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return false
+	}
+
+	scheme := strings.ToLower(parsed.Scheme)
+	return (scheme == "http" || scheme == "https") && parsed.Host != ""
+}
+
+// NormalizeLinkURL rewrites the url so that the same page is always saved the same way, which is what duplicated
+// links are detected by: the scheme and host are lowercased, default ports and tracking parameters are removed, and the
+// remaining parameters are sorted.
+func NormalizeLinkURL(raw string) (string, error) {
+	if !isWebURL(raw) {
+		return "", errUnsupportedLinkURL
+	}
+
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", err
+	}
+
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	parsed.Host = strings.ToLower(parsed.Host)
+	if parsed.Scheme == "http" {
+		parsed.Host = strings.TrimSuffix(parsed.Host, ":80")
+	} else {
+		parsed.Host = strings.TrimSuffix(parsed.Host, ":443")
+	}
+
+	if parsed.Path == "" {
+		parsed.Path = "/"
+	}
+
+	// queries that can't be parsed are kept as they are, since there is no telling which parts are parameters
+	if query, err := url.ParseQuery(parsed.RawQuery); err == nil {
+		for name := range query {
+			lowerName := strings.ToLower(name)
+			if trackingParameters[lowerName] || strings.HasPrefix(lowerName, "utm_") {
+				query.Del(name)
+			}
+		}
+		parsed.RawQuery = query.Encode()
+	}
+	parsed.ForceQuery = false
+
+	normalized := parsed.String()
+	if len(normalized) > maxLinkURLLength {
+		return "", errors.New("url is too long")
+	}
+
+	return normalized, nil
+}
+
+// normalizeLinkURLs normalizes a list of urls, reporting the ones that can't be saved and the ones repeated as errors
+// of field[i].url.
+func normalizeLinkURLs(field string, urls []string) ([]string, []spec.FieldError) {
+	var fields []spec.FieldError
+
+	normalized := make([]string, len(urls))
+	seen := make(map[string]int, len(urls))
+	for i, raw := range urls {
+		name := fmt.Sprintf("%s[%d].url", field, i)
+
+		link, err := NormalizeLinkURL(raw)
+		if err != nil {
+			fields = append(fields, spec.FieldError{Field: name, Message: "URL inválida. Use um endereço começando com http:// ou https://."})
+			continue
+		}
+
+		if first, ok := seen[link]; ok {
+			fields = append(fields, spec.FieldError{Field: name, Message: fmt.Sprintf("Link repetido, já listado em %s[%d].", field, first)})
+			continue
+		}
+
+		seen[link] = i
+		normalized[i] = link
+	}
+
+	return normalized, fields
+}
+
+func invalidLinkURL() spec.Error {
+	return spec.Error{
+		Message: "Input inválido.",
+		Errors:  []spec.FieldError{{Field: "url", Message: "URL inválida. Use um endereço começando com http:// ou https://."}},
+	}
+}
+
+func duplicatedLink() spec.Error {
+	return spec.Error{
+		Message: "Esse link já foi adicionado à viagem.",
+		Errors:  []spec.FieldError{{Field: "url", Message: "Link repetido."}},
+	}
+}
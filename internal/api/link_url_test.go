@@ -0,0 +1,115 @@
+package api
+
+import (
+	"nlw-journey/internal/api/spec"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIsWebURL(t *testing.T) {
+	tests := map[string]bool{
+		"https://example.com":          true,
+		"HTTP://example.com/roteiro":   true,
+		"  https://example.com  ":      true,
+		"javascript:alert(1)":          false,
+		"file:///etc/passwd":           false,
+		"data:text/html,<h1>oi</h1>":   false,
+		"//example.com/roteiro":        false,
+		"ftp://example.com/roteiro":    false,
+		"https:///sem-host":            false,
+		"example.com/roteiro":          false,
+		"https://exa mple.com/roteiro": false,
+	}
+
+	for raw, want := range tests {
+		if got := isWebURL(raw); got != want {
+			t.Errorf("isWebURL(%q) = %t, want %t", raw, got, want)
+		}
+	}
+}
+
+func TestNormalizeLinkURL(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{name: "scheme and host lowercased", raw: "HTTPS://Example.COM/Roteiro", want: "https://example.com/Roteiro"},
+		{name: "default http port removed", raw: "http://example.com:80/roteiro", want: "http://example.com/roteiro"},
+		{name: "default https port removed", raw: "https://example.com:443/roteiro", want: "https://example.com/roteiro"},
+		{name: "other ports kept", raw: "https://example.com:8443/roteiro", want: "https://example.com:8443/roteiro"},
+		{name: "https port kept on http", raw: "http://example.com:443/roteiro", want: "http://example.com:443/roteiro"},
+		{
+			name: "tracking parameters removed",
+			raw:  "https://example.com/roteiro?utm_source=news&utm_medium=email&fbclid=abc&dia=2",
+			want: "https://example.com/roteiro?dia=2",
+		},
+		{name: "mixed case tracking parameters removed", raw: "https://example.com/?UTM_Source=news&FBCLID=abc", want: "https://example.com/"},
+		{name: "parameters sorted", raw: "https://example.com/busca?q=praia&b=2&a=1", want: "https://example.com/busca?a=1&b=2&q=praia"},
+		{name: "empty path", raw: "https://example.com", want: "https://example.com/"},
+		{name: "empty query", raw: "https://example.com/roteiro?", want: "https://example.com/roteiro"},
+		{name: "surrounding spaces", raw: "  https://example.com/roteiro ", want: "https://example.com/roteiro"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NormalizeLinkURL(test.raw)
+			if err != nil {
+				t.Fatalf("NormalizeLinkURL(%q) error = %v", test.raw, err)
+			}
+			if got != test.want {
+				t.Errorf("NormalizeLinkURL(%q) = %q, want %q", test.raw, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeLinkURLRejects(t *testing.T) {
+	prefix := "https://example.com/"
+
+	tests := map[string]string{
+		"javascript":         "javascript:alert(1)",
+		"file":               "file:///etc/passwd",
+		"data":               "data:text/html,<h1>oi</h1>",
+		"scheme relative":    "//example.com/roteiro",
+		"longer than column": prefix + strings.Repeat("a", maxLinkURLLength-len(prefix)+1),
+	}
+
+	for name, raw := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got, err := NormalizeLinkURL(raw); err == nil {
+				t.Errorf("NormalizeLinkURL(%q) = %q, want an error", raw, got)
+			}
+		})
+	}
+
+	longest := prefix + strings.Repeat("a", maxLinkURLLength-len(prefix))
+	if _, err := NormalizeLinkURL(longest); err != nil {
+		t.Errorf("NormalizeLinkURL() of %d characters error = %v", len(longest), err)
+	}
+}
+
+func TestNormalizeLinkURLs(t *testing.T) {
+	urls := []string{
+		"https://example.com/roteiro?utm_source=news",
+		"javascript:alert(1)",
+		"HTTPS://EXAMPLE.com:443/roteiro",
+		"https://example.com/hotel",
+	}
+
+	got, fields := normalizeLinkURLs("links", urls)
+
+	want := []string{"https://example.com/roteiro", "", "", "https://example.com/hotel"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("normalizeLinkURLs() = %v, want %v", got, want)
+	}
+
+	wantFields := []spec.FieldError{
+		{Field: "links[1].url", Message: "URL inválida. Use um endereço começando com http:// ou https://."},
+		{Field: "links[2].url", Message: "Link repetido, já listado em links[0]."},
+	}
+	if !reflect.DeepEqual(fields, wantFields) {
+		t.Errorf("normalizeLinkURLs() fields = %v, want %v", fields, wantFields)
+	}
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"strings"
+	"time"
+)
+
+const (
+	defaultTripsPageSize = 20
+	maxTripsPageSize     = 100
+)
+
+// tripsCursor points right after the last trip of a page. It is handed to clients as an opaque string, and carries
+// the sort it was created for so it can't be reused with a different one.
+type tripsCursor struct {
+	Sort     string    `json:"s"`
+	StartsAt time.Time `json:"t"`
+	ID       uuid.UUID `json:"id"`
+}
+
+func encodeTripsCursor(cursor tripsCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeTripsCursor(raw string) (tripsCursor, error) {
+	var cursor tripsCursor
+
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return cursor, err
+	}
+
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return cursor, err
+	}
+
+	if cursor.ID == uuid.Nil {
+		return cursor, errors.New("cursor without id")
+	}
+
+	return cursor, nil
+}
+
+// GetTrips List the trips of the authenticated user.
+// (GET /trips)
+func (api API) GetTrips(_ http.ResponseWriter, r *http.Request, params spec.GetTripsParams) *spec.Response {
+	identity, ok := IdentityFromContext(r.Context())
+	if !ok {
+		return spec.GetTripsJSON401Response(spec.Error{Message: "É necessário estar autenticado."})
+	}
+
+	// users may only list their own trips, so the e-mail filters just narrow down to owned or joined trips
+	query := pgstore.ListTripsParams{PageSize: defaultTripsPageSize + 1}
+
+	if params.OwnerEmail != nil {
+		if !strings.EqualFold(string(*params.OwnerEmail), identity.Email) {
+			return spec.GetTripsJSON403Response(spec.Error{Message: "Você só pode listar as suas próprias viagens."})
+		}
+		query.OwnerEmail = pgtype.Text{String: identity.Email, Valid: true}
+	}
+
+	if params.ParticipantEmail != nil {
+		if !strings.EqualFold(string(*params.ParticipantEmail), identity.Email) {
+			return spec.GetTripsJSON403Response(spec.Error{Message: "Você só pode listar as suas próprias viagens."})
+		}
+		query.ParticipantEmail = pgtype.Text{String: identity.Email, Valid: true}
+	}
+
+	if !query.OwnerEmail.Valid && !query.ParticipantEmail.Valid {
+		query.MemberEmail = pgtype.Text{String: identity.Email, Valid: true}
+	}
+
+	var fields []spec.FieldError
+
+	if params.Status != nil {
+		var status spec.TripStatus
+		if err := status.FromValue(string(*params.Status)); err != nil {
+			fields = append(fields, spec.FieldError{Field: "status", Message: "Deve ser um dos valores: draft, confirmed, cancelled, completed."})
+		}
+		query.Status = pgstore.NullTripStatus{TripStatus: pgstore.TripStatus(status.ToValue()), Valid: true}
+	}
+
+	if params.StartsAfter != nil {
+		query.StartsAfter = pgtype.Timestamptz{Time: *params.StartsAfter, Valid: true}
+	}
+
+	if params.EndsBefore != nil {
+		query.EndsBefore = pgtype.Timestamptz{Time: *params.EndsBefore, Valid: true}
+	}
+
+	sort := "starts_at"
+	if params.Sort != nil {
+		sort = string(*params.Sort)
+		if sort != "starts_at" && sort != "-starts_at" {
+			fields = append(fields, spec.FieldError{Field: "sort", Message: "Deve ser um dos valores: starts_at, -starts_at."})
+		}
+	}
+
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxTripsPageSize {
+			fields = append(fields, spec.FieldError{Field: "limit", Message: "Deve estar entre 1 e 100."})
+		}
+		query.PageSize = int32(*params.Limit) + 1
+	}
+
+	if params.Cursor != nil {
+		cursor, err := decodeTripsCursor(*params.Cursor)
+		if err != nil || cursor.Sort != sort {
+			fields = append(fields, spec.FieldError{Field: "cursor", Message: "Cursor inválido."})
+		}
+		query.CursorStartsAt = pgtype.Timestamptz{Time: cursor.StartsAt, Valid: true}
+		query.CursorID = pgtype.UUID{Bytes: cursor.ID, Valid: true}
+	}
+
+	if len(fields) > 0 {
+		return spec.GetTripsJSON400Response(spec.Error{Message: "Input inválido.", Errors: fields})
+	}
+
+	var trips []pgstore.Trip
+	var err error
+	if sort == "-starts_at" {
+		trips, err = api.repository.ListTripsDesc(r.Context(), pgstore.ListTripsDescParams(query))
+	} else {
+		trips, err = api.repository.ListTrips(r.Context(), query)
+	}
+	if err != nil {
+		api.logger.Error("failed to list trips", zap.Error(err), zap.String("email", identity.Email))
+		return spec.GetTripsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	// one trip more than the page size is loaded to know whether there is a next page
+	var nextCursor *string
+	if pageSize := int(query.PageSize) - 1; len(trips) > pageSize {
+		trips = trips[:pageSize]
+		last := trips[pageSize-1]
+
+		cursor := encodeTripsCursor(tripsCursor{Sort: sort, StartsAt: last.StartsAt.Time, ID: last.ID})
+		nextCursor = &cursor
+	}
+
+	now := time.Now()
+	mappedTrips := make([]spec.Trip, len(trips))
+	for i, trip := range trips {
+		mappedTrips[i] = specTrip(trip, now)
+	}
+
+	return spec.GetTripsJSON200Response(spec.ListTripsResponse{
+		Trips:      mappedTrips,
+		NextCursor: nextCursor,
+	})
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+)
+
+// DeleteTripsTripIDParticipantsParticipantID Remove a participant from the trip.
+// (DELETE /trips/{tripId}/participants/{participantId})
+func (api API) DeleteTripsTripIDParticipantsParticipantID(_ http.ResponseWriter, r *http.Request, _tripID string, _participantID string, params spec.DeleteTripsTripIDParticipantsParticipantIDParams) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	participantID, err := uuid.Parse(_participantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "ID de participante inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTripChange(r.Context(), tripID, ownerAccess); apiErr != nil {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(*apiErr).Status(status)
+	}
+
+	participant, apiErr := api.getParticipant(r.Context(), participantID)
+	if apiErr != nil {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(*apiErr)
+	}
+
+	if participant.TripID != tripID {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "Participante não encontrado."})
+	}
+
+	notify := params.Notify != nil && *params.Notify
+
+	removed, err := api.repository.RemoveParticipant(r.Context(), api.pool, participant, notify)
+	if err != nil {
+		api.logger.Error("failed to remove participant", zap.Error(err), zap.String("tripID", _tripID), zap.String("participantID", _participantID))
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if !removed {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "Participante não encontrado."})
+	}
+
+	return spec.DeleteTripsTripIDParticipantsParticipantIDJSON204Response(struct{}{})
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"encoding/json"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+)
+
+// PostAuthMagicLink Send a sign in link to the given e-mail.
+// (POST /auth/magic-link)
+func (api API) PostAuthMagicLink(_ http.ResponseWriter, r *http.Request) *spec.Response {
+	var body spec.PostAuthMagicLinkJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostAuthMagicLinkJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostAuthMagicLinkJSON400Response(invalidInput(err))
+	}
+
+	email := string(body.Email)
+
+	isKnown, err := api.repository.IsKnownEmail(r.Context(), email)
+	if err != nil {
+		api.logger.Error("failed to check e-mail on PostAuthMagicLink", zap.Error(err))
+		return spec.PostAuthMagicLinkJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	// answering the same way for unknown e-mails avoids leaking who uses the application
+	if !isKnown {
+		return spec.PostAuthMagicLinkJSON204Response(struct{}{})
+	}
+
+	if err := api.repository.EnqueueMail(r.Context(), pgstore.MailKindMagicLink, pgstore.MailPayload{Email: email}); err != nil {
+		api.logger.Error("failed to enqueue magic link e-mail", zap.Error(err))
+		return spec.PostAuthMagicLinkJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostAuthMagicLinkJSON204Response(struct{}{})
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"strings"
+	"time"
+)
+
+// participantProfile holds the optional details a participant may fill in when answering an invitation.
+// Fields left nil keep their current value.
+type participantProfile struct {
+	Name  *string `validate:"omitempty,min=2,max=255"`
+	Phone *string `validate:"omitempty,min=8,max=32"`
+}
+
+// canAnswerRSVP tells whether a participant may move from one RSVP status to another. Every invitation starts
+// as pending and can be answered with any other status, which can then be changed freely, but never back to pending.
+func canAnswerRSVP(from, to pgstore.RsvpStatus) bool {
+	return from != to && to != pgstore.RsvpStatusPending
+}
+
+// PatchParticipantsParticipantIDRsvp Answers a trip invitation.
+// (PATCH /participants/{participantId}/rsvp)
+func (api API) PatchParticipantsParticipantIDRsvp(_ http.ResponseWriter, r *http.Request, _participantID string) *spec.Response {
+	participantID, err := uuid.Parse(_participantID)
+	if err != nil {
+		return spec.PatchParticipantsParticipantIDRsvpJSON400Response(spec.Error{Message: "Uuid inválido."})
+	}
+
+	var body spec.PatchParticipantsParticipantIDRsvpJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchParticipantsParticipantIDRsvpJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchParticipantsParticipantIDRsvpJSON400Response(invalidInput(err))
+	}
+
+	identity, ok := IdentityFromContext(r.Context())
+	if !ok {
+		return spec.PatchParticipantsParticipantIDRsvpJSON401Response(spec.Error{Message: "É necessário estar autenticado."})
+	}
+
+	participant, apiErr := api.getParticipant(r.Context(), participantID)
+	if apiErr != nil {
+		return spec.PatchParticipantsParticipantIDRsvpJSON400Response(*apiErr)
+	}
+
+	if !strings.EqualFold(participant.Email, identity.Email) {
+		return spec.PatchParticipantsParticipantIDRsvpJSON403Response(spec.Error{Message: "Apenas o próprio participante pode responder ao convite."})
+	}
+
+	profile := participantProfile{Name: body.Name, Phone: body.Phone}
+	if apiErr := api.answerRSVP(r.Context(), participant, pgstore.RsvpStatus(body.Status), profile, nil); apiErr != nil {
+		return spec.PatchParticipantsParticipantIDRsvpJSON400Response(*apiErr)
+	}
+
+	return spec.PatchParticipantsParticipantIDRsvpJSON204Response(struct{}{})
+}
+
+func (api API) getParticipant(ctx context.Context, participantID uuid.UUID) (pgstore.Participant, *spec.Error) {
+	participant, err := api.repository.GetParticipant(ctx, participantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return participant, &spec.Error{Message: "Participante não encontrado."}
+		}
+
+		api.logger.Error("Failed to get participant", zap.Error(err), zap.String("participant's ID", participantID.String()))
+		return participant, &spec.Error{Message: "Alguma coisa deu errado... Tente novamente mais tarde."}
+	}
+
+	return participant, nil
+}
+
+func (api API) answerRSVP(ctx context.Context, participant pgstore.Participant, status pgstore.RsvpStatus, profile participantProfile, usedToken *pgstore.UseTokenParams) *spec.Error {
+	if !canAnswerRSVP(participant.Status, status) {
+		if participant.Status == status {
+			return &spec.Error{Message: "O convite já foi respondido com essa resposta."}
+		}
+		return &spec.Error{Message: "Resposta inválida para esse convite."}
+	}
+
+	trip, err := api.repository.GetTrip(ctx, participant.TripID)
+	if err != nil {
+		api.logger.Error("Failed to get participant's trip", zap.Error(err), zap.String("participant's ID", participant.ID.String()))
+		return &spec.Error{Message: "Alguma coisa deu errado... Tente novamente mais tarde."}
+	}
+
+	if apiErr := ensureTripIsEditable(trip); apiErr != nil {
+		return apiErr
+	}
+
+	if err := api.repository.AnswerRSVP(ctx, api.pool, pgstore.UpdateParticipantStatusParams{
+		Status: status,
+		Name:   stringToText(profile.Name),
+		Phone:  stringToText(profile.Phone),
+		ID:     participant.ID,
+	}, usedToken); err != nil {
+		if errors.Is(err, pgstore.ErrTokenUsed) {
+			return &spec.Error{Message: "Esse link já foi utilizado."}
+		}
+
+		api.logger.Error("Failed to update participant's RSVP", zap.Error(err), zap.String("participant's ID", participant.ID.String()))
+		return &spec.Error{Message: "Alguma coisa deu errado... Tente novamente mais tarde."}
+	}
+
+	return nil
+}
+
+func timestampToPointer(timestamp pgtype.Timestamp) *time.Time {
+	if !timestamp.Valid {
+		return nil
+	}
+	return &timestamp.Time
+}
+
+func textToPointer(text pgtype.Text) *string {
+	if !text.Valid {
+		return nil
+	}
+	return &text.String
+}
+
+func stringToText(value *string) pgtype.Text {
+	if value == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: strings.TrimSpace(*value), Valid: true}
+}
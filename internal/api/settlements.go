@@ -0,0 +1,131 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"strings"
+)
+
+// GetTripsTripIDSettlements List the payments recorded to settle up the trip.
+// (GET /trips/{tripId}/settlements)
+func (api API) GetTripsTripIDSettlements(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.GetTripsTripIDSettlementsJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTrip(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.GetTripsTripIDSettlementsJSON400Response(*apiErr).Status(status)
+	}
+
+	settlements, err := api.repository.GetTripSettlements(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's settlements", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDSettlementsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	mappedSettlements := make([]spec.Settlement, len(settlements))
+	for i, settlement := range settlements {
+		mappedSettlements[i] = spec.Settlement{
+			ID:        settlement.ID.String(),
+			FromEmail: types.Email(settlement.FromEmail),
+			ToEmail:   types.Email(settlement.ToEmail),
+			Amount:    settlement.Amount,
+			Currency:  settlement.Currency,
+			CreatedAt: settlement.CreatedAt.Time,
+		}
+	}
+
+	return spec.GetTripsTripIDSettlementsJSON200Response(spec.ListTripSettlementsResponse{Settlements: mappedSettlements})
+}
+
+// PostTripsTripIDSettlements Record a payment made to settle up the trip.
+// (POST /trips/{tripId}/settlements)
+func (api API) PostTripsTripIDSettlements(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.PostTripsTripIDSettlementsJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeExpenseChange(r.Context(), tripID, memberAccess)
+	if apiErr != nil {
+		return spec.PostTripsTripIDSettlementsJSON400Response(*apiErr).Status(status)
+	}
+
+	var body spec.PostTripsTripIDSettlementsJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDSettlementsJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDSettlementsJSON400Response(invalidInput(err))
+	}
+
+	members, apiErr := api.tripMembers(r.Context(), trip)
+	if apiErr != nil {
+		return spec.PostTripsTripIDSettlementsJSON400Response(*apiErr)
+	}
+
+	var fields []spec.FieldError
+	if !members[strings.ToLower(string(body.FromEmail))] {
+		fields = append(fields, spec.FieldError{Field: "from_email", Message: "Deve ser o dono ou um participante da viagem."})
+	}
+	if !members[strings.ToLower(string(body.ToEmail))] {
+		fields = append(fields, spec.FieldError{Field: "to_email", Message: "Deve ser o dono ou um participante da viagem."})
+	} else if strings.EqualFold(string(body.FromEmail), string(body.ToEmail)) {
+		fields = append(fields, spec.FieldError{Field: "to_email", Message: "Quem recebe deve ser diferente de quem paga."})
+	}
+
+	if len(fields) > 0 {
+		return spec.PostTripsTripIDSettlementsJSON400Response(spec.Error{Message: "Input inválido.", Errors: fields})
+	}
+
+	settlementID, err := api.repository.InsertSettlement(r.Context(), pgstore.InsertSettlementParams{
+		TripID:    tripID,
+		FromEmail: string(body.FromEmail),
+		ToEmail:   string(body.ToEmail),
+		Amount:    body.Amount,
+		Currency:  body.Currency,
+	})
+	if err != nil {
+		api.logger.Error("failed to insert settlement", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.PostTripsTripIDSettlementsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostTripsTripIDSettlementsJSON201Response(spec.CreateTripSettlementResponse{SettlementID: settlementID.String()})
+}
+
+// DeleteTripsTripIDSettlementsSettlementID Delete a settlement payment recorded by mistake.
+// (DELETE /trips/{tripId}/settlements/{settlementId})
+func (api API) DeleteTripsTripIDSettlementsSettlementID(_ http.ResponseWriter, r *http.Request, _tripID string, _settlementID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDSettlementsSettlementIDJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	settlementID, err := uuid.Parse(_settlementID)
+	if err != nil {
+		return spec.DeleteTripsTripIDSettlementsSettlementIDJSON400Response(spec.Error{Message: "ID de pagamento inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeExpenseChange(r.Context(), tripID, memberAccess); apiErr != nil {
+		return spec.DeleteTripsTripIDSettlementsSettlementIDJSON400Response(*apiErr).Status(status)
+	}
+
+	deleted, err := api.repository.DeleteSettlement(r.Context(), pgstore.DeleteSettlementParams{ID: settlementID, TripID: tripID})
+	if err != nil {
+		api.logger.Error("failed to delete settlement", zap.Error(err), zap.String("settlementID", _settlementID))
+		return spec.DeleteTripsTripIDSettlementsSettlementIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDSettlementsSettlementIDJSON400Response(spec.Error{Message: "Pagamento não encontrado."})
+	}
+
+	return spec.DeleteTripsTripIDSettlementsSettlementIDJSON204Response(struct{}{})
+}
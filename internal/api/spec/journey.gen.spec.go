@@ -23,31 +23,253 @@ import (
 	"github.com/go-chi/render"
 )
 
+// Defines values for ParticipantStatus.
+var (
+	UnknownParticipantStatus = ParticipantStatus{}
+
+	ParticipantStatusConfirmed = ParticipantStatus{"confirmed"}
+
+	ParticipantStatusDeclined = ParticipantStatus{"declined"}
+
+	ParticipantStatusMaybe = ParticipantStatus{"maybe"}
+
+	ParticipantStatusPending = ParticipantStatus{"pending"}
+)
+
+// Defines values for TripStatus.
+var (
+	UnknownTripStatus = TripStatus{}
+
+	TripStatusCancelled = TripStatus{"cancelled"}
+
+	TripStatusCompleted = TripStatus{"completed"}
+
+	TripStatusConfirmed = TripStatus{"confirmed"}
+
+	TripStatusDraft = TripStatus{"draft"}
+)
+
+// A proposed activity and its results. kind is vote or poll, and status is proposed until the owner promotes it to the activity in activity_id. my_vote and my_option_id hold the vote of the authenticated member, if any.
+type ActivityProposal struct {
+	ActivityID    *string                  `json:"activity_id"`
+	CreatedAt     time.Time                `json:"created_at"`
+	Downvotes     int                      `json:"downvotes"`
+	ID            string                   `json:"id"`
+	Kind          string                   `json:"kind"`
+	MyOptionID    *string                  `json:"my_option_id"`
+	MyVote        *string                  `json:"my_vote"`
+	OccursAt      *time.Time               `json:"occurs_at"`
+	OccursAtLocal *time.Time               `json:"occurs_at_local"`
+	Options       []ActivityProposalOption `json:"options"`
+	ProposedBy    openapi_types.Email      `json:"proposed_by"`
+	Score         int                      `json:"score"`
+	Status        string                   `json:"status"`
+	Title         string                   `json:"title"`
+	Upvotes       int                      `json:"upvotes"`
+}
+
+// An activity proposed to the trip members. Proposals with options are multiple-choice polls, the others are voted up or down.
+type ActivityProposalInput struct {
+	OccursAt *time.Time                    `json:"occurs_at"`
+	Options  []ActivityProposalOptionInput `json:"options,omitempty" validate:"omitempty,min=2,max=10,dive"`
+	Title    string                        `json:"title" validate:"required,max=255"`
+}
+
+// ActivityProposalOption defines model for ActivityProposalOption.
+type ActivityProposalOption struct {
+	ID       string     `json:"id"`
+	Label    string     `json:"label"`
+	OccursAt *time.Time `json:"occurs_at"`
+	Votes    int        `json:"votes"`
+}
+
+// An answer of a poll. When it has a time, it is used once the winning option is promoted.
+type ActivityProposalOptionInput struct {
+	Label    string     `json:"label" validate:"required,max=255"`
+	OccursAt *time.Time `json:"occurs_at"`
+}
+
+// A vote on a proposal: up or down on proposals voted that way, or the chosen option_id on polls.
+type ActivityProposalVoteInput struct {
+	OptionID *string `json:"option_id" validate:"omitempty,uuid"`
+	Vote     *string `json:"vote" validate:"omitempty,oneof=up down"`
+}
+
+// BudgetCategorySummary defines model for BudgetCategorySummary.
+type BudgetCategorySummary struct {
+	Budgeted int64  `json:"budgeted"`
+	Category string `json:"category"`
+	Spent    int64  `json:"spent"`
+}
+
+// CalendarFeed defines model for CalendarFeed.
+type CalendarFeed struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+// A checklist with its items, in the order they were arranged.
+type Checklist struct {
+	CreatedAt time.Time       `json:"created_at"`
+	ID        string          `json:"id"`
+	Items     []ChecklistItem `json:"items"`
+	Kind      string          `json:"kind"`
+	Title     string          `json:"title"`
+}
+
+// A checklist of the trip, either things to pack or things to do.
+type ChecklistInput struct {
+	Kind  string `json:"kind" validate:"required,oneof=packing todo"`
+	Title string `json:"title" validate:"required,max=255"`
+}
+
+// ChecklistItem defines model for ChecklistItem.
+type ChecklistItem struct {
+	AssigneeEmail *openapi_types.Email `json:"assignee_email"`
+	DoneAt        *time.Time           `json:"done_at"`
+	Group         *string              `json:"group"`
+	ID            string               `json:"id"`
+	IsDone        bool                 `json:"is_done"`
+	Position      int                  `json:"position"`
+	Title         string               `json:"title"`
+}
+
+// An item of a checklist. Items with the same group are shown together, and the assignee must be the trip owner or one of its participants. Items are not done unless is_done says otherwise.
+type ChecklistItemInput struct {
+	AssigneeEmail *openapi_types.Email `json:"assignee_email" validate:"omitempty,email"`
+	Group         *string              `json:"group" validate:"omitempty,max=255"`
+	IsDone        *bool                `json:"is_done,omitempty"`
+	Title         string               `json:"title" validate:"required,max=255"`
+}
+
+// CreateActivityProposalResponse defines model for CreateActivityProposalResponse.
+type CreateActivityProposalResponse struct {
+	ProposalID string `json:"proposal_id"`
+}
+
+// CreateChecklistItemResponse defines model for CreateChecklistItemResponse.
+type CreateChecklistItemResponse struct {
+	ItemID string `json:"item_id"`
+}
+
 // CreateTripActivitiesResponse defines model for CreateTripActivitiesResponse.
 type CreateTripActivitiesResponse struct {
 	ActivityID string `json:"activityId"`
 }
 
+// CreateTripChecklistResponse defines model for CreateTripChecklistResponse.
+type CreateTripChecklistResponse struct {
+	ChecklistID string `json:"checklist_id"`
+}
+
+// CreateTripExpenseResponse defines model for CreateTripExpenseResponse.
+type CreateTripExpenseResponse struct {
+	ExpenseID string `json:"expense_id"`
+}
+
 // CreateTripResponse defines model for CreateTripResponse.
 type CreateTripResponse struct {
 	TripID string `json:"tripId"`
 }
 
+// CreateTripSettlementResponse defines model for CreateTripSettlementResponse.
+type CreateTripSettlementResponse struct {
+	SettlementID string `json:"settlement_id"`
+}
+
+// CreateTripTemplateResponse defines model for CreateTripTemplateResponse.
+type CreateTripTemplateResponse struct {
+	TemplateID string `json:"template_id"`
+}
+
+// CurrencyBalances defines model for CurrencyBalances.
+type CurrencyBalances struct {
+	Currency     string               `json:"currency"`
+	Participants []ParticipantBalance `json:"participants"`
+	Transfers    []SettleUpTransfer   `json:"transfers"`
+}
+
 // Bad request
 type Error struct {
-	Message string `json:"message" validate:"required"`
+	Errors  []FieldError `json:"errors,omitempty"`
+	Message string       `json:"message" validate:"required"`
+}
+
+// An expense and how it is split. base_amount is the amount converted to the trip's base currency with exchange_rate, the rate in effect when the expense was recorded. They are null for expenses recorded before the trip had a base currency in a different currency.
+type Expense struct {
+	ActivityID   *string             `json:"activity_id"`
+	Amount       int64               `json:"amount"`
+	BaseAmount   *int64              `json:"base_amount"`
+	BaseCurrency *string             `json:"base_currency"`
+	Category     string              `json:"category"`
+	CreatedAt    time.Time           `json:"created_at"`
+	Currency     string              `json:"currency"`
+	Description  string              `json:"description"`
+	ExchangeRate *string             `json:"exchange_rate"`
+	ID           string              `json:"id"`
+	PayerEmail   openapi_types.Email `json:"payer_email"`
+	SplitKind    string              `json:"split_kind"`
+	Splits       []ExpenseSplit      `json:"splits"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+// An expense paid by one of the trip's members. The amount is in the currency's minor units, such as cents. Expenses without a category are filed under other.
+type ExpenseInput struct {
+	ActivityID  *string             `json:"activity_id" validate:"omitempty,uuid"`
+	Amount      int64               `json:"amount" validate:"required,min=1,max=1000000000000"`
+	Category    *string             `json:"category,omitempty" validate:"omitempty,oneof=accommodation transport food activities shopping other"`
+	Currency    string              `json:"currency" validate:"required,iso4217"`
+	Description string              `json:"description" validate:"required,max=255"`
+	PayerEmail  openapi_types.Email `json:"payer_email" validate:"required,email"`
+	SplitKind   string              `json:"split_kind" validate:"required,oneof=equal shares exact"`
+	Splits      []ExpenseSplitInput `json:"splits" validate:"required,min=1,max=100,dive"`
+}
+
+// The part of the expense owed by a participant, in the currency's minor units.
+type ExpenseSplit struct {
+	Amount int64               `json:"amount"`
+	Email  openapi_types.Email `json:"email"`
+	Shares *int                `json:"shares"`
+}
+
+// A participant the expense is split with. shares is required when the expense is split by shares and amount when it is split by exact amounts.
+type ExpenseSplitInput struct {
+	Amount *int64              `json:"amount" validate:"omitempty,min=0,max=1000000000000"`
+	Email  openapi_types.Email `json:"email" validate:"required,email"`
+	Shares *int                `json:"shares" validate:"omitempty,min=1,max=1000"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// GetTripActivitiesDay defines model for GetTripActivitiesDay.
+type GetTripActivitiesDay struct {
+	Activities []GetTripActivitiesInner `json:"activities"`
+	Date       openapi_types.Date       `json:"date"`
+	IsPast     bool                     `json:"is_past"`
 }
 
 // GetTripActivitiesInner defines model for GetTripActivitiesInner.
 type GetTripActivitiesInner struct {
-	ID       string    `json:"id" validate:"required,uuid"`
-	OccursAt time.Time `json:"occurs_at" validate:"required"`
-	Title    string    `json:"title" validate:"required"`
+	ID            string    `json:"id" validate:"required,uuid"`
+	OccursAt      time.Time `json:"occurs_at" validate:"required"`
+	OccursAtLocal time.Time `json:"occurs_at_local"`
+	Title         string    `json:"title" validate:"required"`
 }
 
 // GetTripActivitiesResponse defines model for GetTripActivitiesResponse.
 type GetTripActivitiesResponse struct {
 	Activities []GetTripActivitiesInner `json:"activities"`
+	Days       []GetTripActivitiesDay   `json:"days,omitempty"`
+	Proposals  []ActivityProposal       `json:"proposals"`
+}
+
+// GetTripBalancesResponse defines model for GetTripBalancesResponse.
+type GetTripBalancesResponse struct {
+	Currencies []CurrencyBalances `json:"currencies"`
 }
 
 // GetTripLinksResponse defines model for GetTripLinksResponse.
@@ -55,53 +277,461 @@ type GetTripLinksResponse struct {
 	Links []Link `json:"links"`
 }
 
-// Link defines model for Link.
+// ImportTripResponse defines model for ImportTripResponse.
+type ImportTripResponse struct {
+	TripID string `json:"trip_id"`
+}
+
+// A link saved on the trip. The preview fields are filled in the background shortly after the link is created or its url changes, and are null until then, or if the page couldn't be read. unfurled_at tells when the preview was fetched.
 type Link struct {
-	ID    string `json:"id"`
-	Title string `json:"title"`
-	URL   string `json:"url"`
+	FaviconURL         *string    `json:"favicon_url"`
+	ID                 string     `json:"id"`
+	PreviewDescription *string    `json:"preview_description"`
+	PreviewImageURL    *string    `json:"preview_image_url"`
+	PreviewTitle       *string    `json:"preview_title"`
+	Title              string     `json:"title"`
+	UnfurledAt         *time.Time `json:"unfurled_at"`
+	URL                string     `json:"url"`
+}
+
+// ListTripBudgetsResponse defines model for ListTripBudgetsResponse.
+type ListTripBudgetsResponse struct {
+	Budgets []TripBudget `json:"budgets"`
+}
+
+// ListTripChecklistsResponse defines model for ListTripChecklistsResponse.
+type ListTripChecklistsResponse struct {
+	Checklists []Checklist `json:"checklists"`
+}
+
+// ListTripExpensesResponse defines model for ListTripExpensesResponse.
+type ListTripExpensesResponse struct {
+	Expenses []Expense `json:"expenses"`
+}
+
+// ListTripSettlementsResponse defines model for ListTripSettlementsResponse.
+type ListTripSettlementsResponse struct {
+	Settlements []Settlement `json:"settlements"`
+}
+
+// ListTripTemplatesResponse defines model for ListTripTemplatesResponse.
+type ListTripTemplatesResponse struct {
+	Templates []TripTemplateSummary `json:"templates"`
+}
+
+// ListTripsResponse defines model for ListTripsResponse.
+type ListTripsResponse struct {
+	NextCursor *string `json:"next_cursor"`
+	Trips      []Trip  `json:"trips"`
 }
 
 // Participant defines model for Participant.
 type Participant struct {
+	ConfirmedAt *time.Time          `json:"confirmed_at"`
+	DeclinedAt  *time.Time          `json:"declined_at"`
 	Email       openapi_types.Email `json:"email"`
 	ID          string              `json:"id"`
+	InvitedAt   time.Time           `json:"invited_at"`
 	IsConfirmed bool                `json:"is_confirmed"`
+	MaybeAt     *time.Time          `json:"maybe_at"`
 	Name        *string             `json:"name"`
+	Phone       *string             `json:"phone"`
+	Status      ParticipantStatus   `json:"status"`
+}
+
+// What a member paid and owes in one currency. paid and owed come from expenses, sent and received from settlements. A positive balance is what the member should get back, a negative one what they still have to pay.
+type ParticipantBalance struct {
+	Balance  int64               `json:"balance"`
+	Email    openapi_types.Email `json:"email"`
+	Owed     int64               `json:"owed"`
+	Paid     int64               `json:"paid"`
+	Received int64               `json:"received"`
+	Sent     int64               `json:"sent"`
+}
+
+// How the proposal is scheduled. For polls, the most voted option is promoted unless option_id picks another one, and the activity is titled after the option. occurs_at defaults to the time of the option or of the proposal.
+type PromoteActivityProposalInput struct {
+	OccursAt *time.Time `json:"occurs_at"`
+	OptionID *string    `json:"option_id" validate:"omitempty,uuid"`
+	Title    *string    `json:"title" validate:"omitempty,max=255"`
+}
+
+// PromoteActivityProposalResponse defines model for PromoteActivityProposalResponse.
+type PromoteActivityProposalResponse struct {
+	ActivityID string `json:"activity_id"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Token     string    `json:"token"`
+}
+
+// SettleUpTransfer defines model for SettleUpTransfer.
+type SettleUpTransfer struct {
+	Amount    int64               `json:"amount"`
+	FromEmail openapi_types.Email `json:"from_email"`
+	ToEmail   openapi_types.Email `json:"to_email"`
+}
+
+// Settlement defines model for Settlement.
+type Settlement struct {
+	Amount    int64               `json:"amount"`
+	CreatedAt time.Time           `json:"created_at"`
+	Currency  string              `json:"currency"`
+	FromEmail openapi_types.Email `json:"from_email"`
+	ID        string              `json:"id"`
+	ToEmail   openapi_types.Email `json:"to_email"`
+}
+
+// A payment made between members to settle up, in the currency's minor units.
+type SettlementInput struct {
+	Amount    int64               `json:"amount" validate:"required,min=1,max=1000000000000"`
+	Currency  string              `json:"currency" validate:"required,iso4217"`
+	FromEmail openapi_types.Email `json:"from_email" validate:"required,email"`
+	ToEmail   openapi_types.Email `json:"to_email" validate:"required,email,nefield=FromEmail"`
 }
 
 // Trip defines model for Trip.
 type Trip struct {
-	Destination string    `json:"destination" validate:"required,min=4"`
-	EndsAt      time.Time `json:"ends_at" validate:"required"`
-	ID          string    `json:"id" validate:"required,uuid"`
-	IsConfirmed bool      `json:"is_confirmed" validate:"required,boolean"`
-	StartsAt    time.Time `json:"starts_at" validate:"required"`
+	BaseCurrency string     `json:"base_currency"`
+	Destination  string     `json:"destination" validate:"required,min=4"`
+	EndsAt       time.Time  `json:"ends_at" validate:"required"`
+	ID           string     `json:"id" validate:"required,uuid"`
+	IsConfirmed  bool       `json:"is_confirmed" validate:"required,boolean"`
+	StartsAt     time.Time  `json:"starts_at" validate:"required"`
+	Status       TripStatus `json:"status"`
+	TimeZone     string     `json:"time_zone"`
+}
+
+// TripBudget defines model for TripBudget.
+type TripBudget struct {
+	Amount       int64  `json:"amount"`
+	BaseAmount   int64  `json:"base_amount"`
+	BaseCurrency string `json:"base_currency"`
+	Category     string `json:"category"`
+	Currency     string `json:"currency"`
+	ExchangeRate string `json:"exchange_rate"`
+}
+
+// How much the trip plans to spend on a category, in the currency's minor units.
+type TripBudgetInput struct {
+	Amount   int64  `json:"amount" validate:"required,min=1,max=1000000000000"`
+	Category string `json:"category" validate:"required,oneof=accommodation transport food activities shopping other"`
+	Currency string `json:"currency" validate:"required,iso4217"`
+}
+
+// Budgets and expenses per category, in minor units of the trip's base currency. Expenses that couldn't be converted aren't part of the totals and are counted in unconverted_expenses.
+type TripBudgetSummary struct {
+	BaseCurrency        string                  `json:"base_currency"`
+	Categories          []BudgetCategorySummary `json:"categories"`
+	TotalBudgeted       int64                   `json:"total_budgeted"`
+	TotalSpent          int64                   `json:"total_spent"`
+	UnconvertedExpenses int                     `json:"unconverted_expenses"`
+}
+
+// A trip with its participants, activities and links, in a format meant to be imported back with POST /trips/import. IDs are left out, since importing always creates new ones.
+type TripBundle struct {
+	Activities    []TripBundleActivity    `json:"activities" validate:"dive"`
+	ExportedAt    time.Time               `json:"exported_at"`
+	Links         []TripBundleLink        `json:"links" validate:"dive"`
+	Participants  []TripBundleParticipant `json:"participants" validate:"dive"`
+	SchemaVersion int                     `json:"schema_version" validate:"required"`
+	Trip          TripBundleTrip          `json:"trip"`
+}
+
+// TripBundleActivity defines model for TripBundleActivity.
+type TripBundleActivity struct {
+	OccursAt time.Time `json:"occurs_at" validate:"required"`
+	Title    string    `json:"title" validate:"required"`
+}
+
+// TripBundleLink defines model for TripBundleLink.
+type TripBundleLink struct {
+	Title string `json:"title" validate:"required"`
+	URL   string `json:"url" validate:"required,weburl,max=2048"`
+}
+
+// TripBundleParticipant defines model for TripBundleParticipant.
+type TripBundleParticipant struct {
+	ConfirmedAt *time.Time          `json:"confirmed_at"`
+	DeclinedAt  *time.Time          `json:"declined_at"`
+	Email       openapi_types.Email `json:"email" validate:"required,email"`
+	InvitedAt   time.Time           `json:"invited_at" validate:"required"`
+	MaybeAt     *time.Time          `json:"maybe_at"`
+	Name        *string             `json:"name" validate:"omitempty,max=255"`
+	Phone       *string             `json:"phone" validate:"omitempty,max=32"`
+	Status      string              `json:"status" validate:"required,oneof=pending confirmed declined maybe"`
+}
+
+// TripBundleTrip defines model for TripBundleTrip.
+type TripBundleTrip struct {
+	BaseCurrency *string             `json:"base_currency,omitempty" validate:"omitempty,iso4217"`
+	Destination  string              `json:"destination" validate:"required,min=4"`
+	EndsAt       time.Time           `json:"ends_at" validate:"required,gtfield=StartsAt"`
+	OwnerEmail   openapi_types.Email `json:"owner_email" validate:"required,email"`
+	OwnerName    string              `json:"owner_name" validate:"required"`
+	StartsAt     time.Time           `json:"starts_at" validate:"required"`
+	Status       string              `json:"status" validate:"required,oneof=draft confirmed cancelled completed"`
+	TimeZone     string              `json:"time_zone" validate:"required,timezone"`
+}
+
+// TripTemplate defines model for TripTemplate.
+type TripTemplate struct {
+	Activities []TripTemplateActivity `json:"activities"`
+	ID         string                 `json:"id"`
+	Links      []TripTemplateLink     `json:"links"`
+	Name       string                 `json:"name"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+}
+
+// An activity happening on the given day of the trip, counting from 1, at the given time (hh:mm) in the trip's time zone.
+type TripTemplateActivity struct {
+	Day   int    `json:"day" validate:"required,min=1,max=366"`
+	Time  string `json:"time" validate:"required,datetime=15:04"`
+	Title string `json:"title" validate:"required,max=255"`
+}
+
+// TripTemplateInput defines model for TripTemplateInput.
+type TripTemplateInput struct {
+	Activities []TripTemplateActivity `json:"activities" validate:"required,dive"`
+	Links      []TripTemplateLink     `json:"links" validate:"required,dive"`
+	Name       string                 `json:"name" validate:"required,max=255"`
+}
+
+// TripTemplateLink defines model for TripTemplateLink.
+type TripTemplateLink struct {
+	Title string `json:"title" validate:"required,max=255"`
+	URL   string `json:"url" validate:"required,weburl,max=2048"`
+}
+
+// TripTemplateSummary defines model for TripTemplateSummary.
+type TripTemplateSummary struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ParticipantStatus defines model for Participant.Status.
+type ParticipantStatus struct {
+	value string
+}
+
+func (t *ParticipantStatus) ToValue() string {
+	return t.value
 }
+func (t ParticipantStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ParticipantStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ParticipantStatus) FromValue(value string) error {
+	switch value {
+
+	case ParticipantStatusConfirmed.value:
+		t.value = value
+		return nil
+
+	case ParticipantStatusDeclined.value:
+		t.value = value
+		return nil
+
+	case ParticipantStatusMaybe.value:
+		t.value = value
+		return nil
+
+	case ParticipantStatusPending.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// TripStatus defines model for Trip.Status.
+type TripStatus struct {
+	value string
+}
+
+func (t *TripStatus) ToValue() string {
+	return t.value
+}
+func (t TripStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TripStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TripStatus) FromValue(value string) error {
+	switch value {
+
+	case TripStatusCancelled.value:
+		t.value = value
+		return nil
+
+	case TripStatusCompleted.value:
+		t.value = value
+		return nil
+
+	case TripStatusConfirmed.value:
+		t.value = value
+		return nil
+
+	case TripStatusDraft.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// PostAuthMagicLinkJSONBody defines parameters for PostAuthMagicLink.
+type PostAuthMagicLinkJSONBody struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+}
+
+// PostConfirmationsParticipantsTokenJSONBody defines parameters for PostConfirmationsParticipantsToken.
+type PostConfirmationsParticipantsTokenJSONBody struct {
+	Name  *string `json:"name" validate:"omitempty,min=2,max=255"`
+	Phone *string `json:"phone" validate:"omitempty,min=8,max=32"`
+}
+
+// PatchParticipantsParticipantIDRsvpJSONBody defines parameters for PatchParticipantsParticipantIDRsvp.
+type PatchParticipantsParticipantIDRsvpJSONBody struct {
+	Name   *string                                          `json:"name,omitempty" validate:"omitempty,min=2,max=255"`
+	Phone  *string                                          `json:"phone,omitempty" validate:"omitempty,min=8,max=32"`
+	Status PatchParticipantsParticipantIDRsvpJSONBodyStatus `json:"status" validate:"required,oneof=confirmed declined maybe"`
+}
+
+// PatchParticipantsParticipantIDRsvpJSONBodyStatus defines parameters for PatchParticipantsParticipantIDRsvp.
+type PatchParticipantsParticipantIDRsvpJSONBodyStatus string
+
+// PostTemplatesJSONBody defines parameters for PostTemplates.
+type PostTemplatesJSONBody TripTemplateInput
+
+// PutTemplatesTemplateIDJSONBody defines parameters for PutTemplatesTemplateID.
+type PutTemplatesTemplateIDJSONBody TripTemplateInput
+
+// GetTripsParams defines parameters for GetTrips.
+type GetTripsParams struct {
+	OwnerEmail       *openapi_types.Email  `json:"owner_email,omitempty"`
+	ParticipantEmail *openapi_types.Email  `json:"participant_email,omitempty"`
+	Status           *GetTripsParamsStatus `json:"status,omitempty"`
+	StartsAfter      *time.Time            `json:"starts_after,omitempty"`
+	EndsBefore       *time.Time            `json:"ends_before,omitempty"`
+	Sort             *GetTripsParamsSort   `json:"sort,omitempty"`
+	Cursor           *string               `json:"cursor,omitempty"`
+	Limit            *int                  `json:"limit,omitempty"`
+}
+
+// GetTripsParamsStatus defines parameters for GetTrips.
+type GetTripsParamsStatus string
+
+// GetTripsParamsSort defines parameters for GetTrips.
+type GetTripsParamsSort string
 
 // PostTripsJSONBody defines parameters for PostTrips.
 type PostTripsJSONBody struct {
+	BaseCurrency   *string   `json:"base_currency,omitempty" validate:"omitempty,iso4217"`
 	Destination    string    `json:"destination" validate:"required,min=4"`
 	EmailsToInvite []string  `json:"emails_to_invite" validate:"required,dive,email"`
-	EndsAt         time.Time `json:"ends_at" validate:"required"`
+	EndsAt         time.Time `json:"ends_at" validate:"required,gtfield=StartsAt"`
 	OwnerEmail     string    `json:"owner_email" validate:"required,email"`
 	OwnerName      string    `json:"owner_name" validate:"required"`
-	StartsAt       time.Time `json:"starts_at" validate:"required"`
+	StartsAt       time.Time `json:"starts_at" validate:"required,future"`
+	TemplateID     *string   `json:"template_id,omitempty" validate:"omitempty,uuid"`
+	TimeZone       *string   `json:"time_zone,omitempty" validate:"omitempty,timezone"`
 }
 
+// PostTripsImportJSONBody defines parameters for PostTripsImport.
+type PostTripsImportJSONBody TripBundle
+
 // PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
 type PutTripsTripIDJSONBody struct {
-	Destination string    `json:"destination" validate:"required,min=4"`
-	EndsAt      time.Time `json:"ends_at" validate:"required"`
-	StartsAt    time.Time `json:"starts_at" validate:"required"`
+	BaseCurrency *string   `json:"base_currency,omitempty" validate:"omitempty,iso4217"`
+	Destination  string    `json:"destination" validate:"required,min=4"`
+	EndsAt       time.Time `json:"ends_at" validate:"required,gtfield=StartsAt"`
+	StartsAt     time.Time `json:"starts_at" validate:"required"`
+	TimeZone     *string   `json:"time_zone,omitempty" validate:"omitempty,timezone"`
+}
+
+// PutTripsTripIDParams defines parameters for PutTripsTripID.
+type PutTripsTripIDParams struct {
+	Activities *PutTripsTripIDParamsActivities `json:"activities,omitempty"`
+}
+
+// PutTripsTripIDParamsActivities defines parameters for PutTripsTripID.
+type PutTripsTripIDParamsActivities string
+
+// GetTripsTripIDActivitiesParams defines parameters for GetTripsTripIDActivities.
+type GetTripsTripIDActivitiesParams struct {
+	Group *GetTripsTripIDActivitiesParamsGroup `json:"group,omitempty"`
 }
 
+// GetTripsTripIDActivitiesParamsGroup defines parameters for GetTripsTripIDActivities.
+type GetTripsTripIDActivitiesParamsGroup string
+
 // PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
 type PostTripsTripIDActivitiesJSONBody struct {
 	OccursAt time.Time `json:"occurs_at" validate:"required"`
 	Title    string    `json:"title" validate:"required"`
 }
 
+// PatchTripsTripIDActivitiesActivityIDJSONBody defines parameters for PatchTripsTripIDActivitiesActivityID.
+type PatchTripsTripIDActivitiesActivityIDJSONBody struct {
+	OccursAt *time.Time `json:"occurs_at,omitempty" validate:"omitempty"`
+	Title    *string    `json:"title,omitempty" validate:"omitempty,min=1"`
+}
+
+// PutTripsTripIDActivitiesActivityIDJSONBody defines parameters for PutTripsTripIDActivitiesActivityID.
+type PutTripsTripIDActivitiesActivityIDJSONBody struct {
+	OccursAt time.Time `json:"occurs_at" validate:"required"`
+	Title    string    `json:"title" validate:"required"`
+}
+
+// PutTripsTripIDBudgetsJSONBody defines parameters for PutTripsTripIDBudgets.
+type PutTripsTripIDBudgetsJSONBody struct {
+	Budgets []TripBudgetInput `json:"budgets" validate:"required,max=6,dive"`
+}
+
+// PostTripsTripIDChecklistsJSONBody defines parameters for PostTripsTripIDChecklists.
+type PostTripsTripIDChecklistsJSONBody ChecklistInput
+
+// PutTripsTripIDChecklistsChecklistIDJSONBody defines parameters for PutTripsTripIDChecklistsChecklistID.
+type PutTripsTripIDChecklistsChecklistIDJSONBody ChecklistInput
+
+// PostTripsTripIDChecklistsChecklistIDItemsJSONBody defines parameters for PostTripsTripIDChecklistsChecklistIDItems.
+type PostTripsTripIDChecklistsChecklistIDItemsJSONBody ChecklistItemInput
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONBody defines parameters for PutTripsTripIDChecklistsChecklistIDItemsItemID.
+type PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONBody ChecklistItemInput
+
+// PutTripsTripIDChecklistsChecklistIDOrderJSONBody defines parameters for PutTripsTripIDChecklistsChecklistIDOrder.
+type PutTripsTripIDChecklistsChecklistIDOrderJSONBody struct {
+	ItemIds []string `json:"item_ids" validate:"required,dive,uuid"`
+}
+
+// PostTripsTripIDCloneJSONBody defines parameters for PostTripsTripIDClone.
+type PostTripsTripIDCloneJSONBody struct {
+	InviteParticipants *bool     `json:"invite_participants,omitempty"`
+	StartsAt           time.Time `json:"starts_at" validate:"required,future"`
+}
+
+// PostTripsTripIDExpensesJSONBody defines parameters for PostTripsTripIDExpenses.
+type PostTripsTripIDExpensesJSONBody ExpenseInput
+
+// PutTripsTripIDExpensesExpenseIDJSONBody defines parameters for PutTripsTripIDExpensesExpenseID.
+type PutTripsTripIDExpensesExpenseIDJSONBody ExpenseInput
+
 // PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
 type PostTripsTripIDInvitesJSONBody struct {
 	Email openapi_types.Email `json:"email" validate:"required,email"`
@@ -110,7 +740,76 @@ type PostTripsTripIDInvitesJSONBody struct {
 // PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
 type PostTripsTripIDLinksJSONBody struct {
 	Title string `json:"title" validate:"required"`
-	URL   string `json:"url" validate:"required,uri"`
+	URL   string `json:"url" validate:"required,weburl,max=2048"`
+}
+
+// PatchTripsTripIDLinksLinkIDJSONBody defines parameters for PatchTripsTripIDLinksLinkID.
+type PatchTripsTripIDLinksLinkIDJSONBody struct {
+	Title *string `json:"title,omitempty" validate:"omitempty,min=1"`
+	URL   *string `json:"url,omitempty" validate:"omitempty,weburl,max=2048"`
+}
+
+// PutTripsTripIDLinksLinkIDJSONBody defines parameters for PutTripsTripIDLinksLinkID.
+type PutTripsTripIDLinksLinkIDJSONBody struct {
+	Title string `json:"title" validate:"required"`
+	URL   string `json:"url" validate:"required,weburl,max=2048"`
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDParams defines parameters for DeleteTripsTripIDParticipantsParticipantID.
+type DeleteTripsTripIDParticipantsParticipantIDParams struct {
+	Notify *bool `json:"notify,omitempty"`
+}
+
+// PostTripsTripIDProposalsJSONBody defines parameters for PostTripsTripIDProposals.
+type PostTripsTripIDProposalsJSONBody ActivityProposalInput
+
+// PostTripsTripIDProposalsProposalIDPromoteJSONBody defines parameters for PostTripsTripIDProposalsProposalIDPromote.
+type PostTripsTripIDProposalsProposalIDPromoteJSONBody PromoteActivityProposalInput
+
+// PutTripsTripIDProposalsProposalIDVoteJSONBody defines parameters for PutTripsTripIDProposalsProposalIDVote.
+type PutTripsTripIDProposalsProposalIDVoteJSONBody ActivityProposalVoteInput
+
+// PostTripsTripIDSettlementsJSONBody defines parameters for PostTripsTripIDSettlements.
+type PostTripsTripIDSettlementsJSONBody SettlementInput
+
+// PostAuthMagicLinkJSONRequestBody defines body for PostAuthMagicLink for application/json ContentType.
+type PostAuthMagicLinkJSONRequestBody PostAuthMagicLinkJSONBody
+
+// Bind implements render.Binder.
+func (PostAuthMagicLinkJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostConfirmationsParticipantsTokenJSONRequestBody defines body for PostConfirmationsParticipantsToken for application/json ContentType.
+type PostConfirmationsParticipantsTokenJSONRequestBody PostConfirmationsParticipantsTokenJSONBody
+
+// Bind implements render.Binder.
+func (PostConfirmationsParticipantsTokenJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDRsvpJSONRequestBody defines body for PatchParticipantsParticipantIDRsvp for application/json ContentType.
+type PatchParticipantsParticipantIDRsvpJSONRequestBody PatchParticipantsParticipantIDRsvpJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDRsvpJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTemplatesJSONRequestBody defines body for PostTemplates for application/json ContentType.
+type PostTemplatesJSONRequestBody PostTemplatesJSONBody
+
+// Bind implements render.Binder.
+func (PostTemplatesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTemplatesTemplateIDJSONRequestBody defines body for PutTemplatesTemplateID for application/json ContentType.
+type PutTemplatesTemplateIDJSONRequestBody PutTemplatesTemplateIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTemplatesTemplateIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
 }
 
 // PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
@@ -121,6 +820,14 @@ func (PostTripsJSONRequestBody) Bind(*http.Request) error {
 	return nil
 }
 
+// PostTripsImportJSONRequestBody defines body for PostTripsImport for application/json ContentType.
+type PostTripsImportJSONRequestBody PostTripsImportJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsImportJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
 // PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
 type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
 
@@ -137,43 +844,179 @@ func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
 	return nil
 }
 
-// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
-type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+// PatchTripsTripIDActivitiesActivityIDJSONRequestBody defines body for PatchTripsTripIDActivitiesActivityID for application/json ContentType.
+type PatchTripsTripIDActivitiesActivityIDJSONRequestBody PatchTripsTripIDActivitiesActivityIDJSONBody
 
 // Bind implements render.Binder.
-func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
+func (PatchTripsTripIDActivitiesActivityIDJSONRequestBody) Bind(*http.Request) error {
 	return nil
 }
 
-// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
-type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+// PutTripsTripIDActivitiesActivityIDJSONRequestBody defines body for PutTripsTripIDActivitiesActivityID for application/json ContentType.
+type PutTripsTripIDActivitiesActivityIDJSONRequestBody PutTripsTripIDActivitiesActivityIDJSONBody
 
 // Bind implements render.Binder.
-func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
+func (PutTripsTripIDActivitiesActivityIDJSONRequestBody) Bind(*http.Request) error {
 	return nil
 }
 
-// Response is a common response struct for all the API calls.
-// A Response object may be instantiated via functions for specific operation responses.
-// It may also be instantiated directly, for the purpose of responding with a single status code.
-type Response struct {
-	body        interface{}
-	Code        int
-	contentType string
-}
+// PutTripsTripIDBudgetsJSONRequestBody defines body for PutTripsTripIDBudgets for application/json ContentType.
+type PutTripsTripIDBudgetsJSONRequestBody PutTripsTripIDBudgetsJSONBody
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
-func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
+// Bind implements render.Binder.
+func (PutTripsTripIDBudgetsJSONRequestBody) Bind(*http.Request) error {
 	return nil
 }
 
-// Status is a builder method to override the default status code for a response.
-func (resp *Response) Status(code int) *Response {
-	resp.Code = code
-	return resp
+// PostTripsTripIDChecklistsJSONRequestBody defines body for PostTripsTripIDChecklists for application/json ContentType.
+type PostTripsTripIDChecklistsJSONRequestBody PostTripsTripIDChecklistsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDChecklistsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDChecklistsChecklistIDJSONRequestBody defines body for PutTripsTripIDChecklistsChecklistID for application/json ContentType.
+type PutTripsTripIDChecklistsChecklistIDJSONRequestBody PutTripsTripIDChecklistsChecklistIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDChecklistsChecklistIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDChecklistsChecklistIDItemsJSONRequestBody defines body for PostTripsTripIDChecklistsChecklistIDItems for application/json ContentType.
+type PostTripsTripIDChecklistsChecklistIDItemsJSONRequestBody PostTripsTripIDChecklistsChecklistIDItemsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDChecklistsChecklistIDItemsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONRequestBody defines body for PutTripsTripIDChecklistsChecklistIDItemsItemID for application/json ContentType.
+type PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONRequestBody PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDChecklistsChecklistIDOrderJSONRequestBody defines body for PutTripsTripIDChecklistsChecklistIDOrder for application/json ContentType.
+type PutTripsTripIDChecklistsChecklistIDOrderJSONRequestBody PutTripsTripIDChecklistsChecklistIDOrderJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDChecklistsChecklistIDOrderJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDCloneJSONRequestBody defines body for PostTripsTripIDClone for application/json ContentType.
+type PostTripsTripIDCloneJSONRequestBody PostTripsTripIDCloneJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDCloneJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDExpensesJSONRequestBody defines body for PostTripsTripIDExpenses for application/json ContentType.
+type PostTripsTripIDExpensesJSONRequestBody PostTripsTripIDExpensesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDExpensesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDExpensesExpenseIDJSONRequestBody defines body for PutTripsTripIDExpensesExpenseID for application/json ContentType.
+type PutTripsTripIDExpensesExpenseIDJSONRequestBody PutTripsTripIDExpensesExpenseIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDExpensesExpenseIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
+type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
+type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDLinksLinkIDJSONRequestBody defines body for PatchTripsTripIDLinksLinkID for application/json ContentType.
+type PatchTripsTripIDLinksLinkIDJSONRequestBody PatchTripsTripIDLinksLinkIDJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDLinksLinkIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDLinksLinkIDJSONRequestBody defines body for PutTripsTripIDLinksLinkID for application/json ContentType.
+type PutTripsTripIDLinksLinkIDJSONRequestBody PutTripsTripIDLinksLinkIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDLinksLinkIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDProposalsJSONRequestBody defines body for PostTripsTripIDProposals for application/json ContentType.
+type PostTripsTripIDProposalsJSONRequestBody PostTripsTripIDProposalsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDProposalsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDProposalsProposalIDPromoteJSONRequestBody defines body for PostTripsTripIDProposalsProposalIDPromote for application/json ContentType.
+type PostTripsTripIDProposalsProposalIDPromoteJSONRequestBody PostTripsTripIDProposalsProposalIDPromoteJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDProposalsProposalIDPromoteJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDProposalsProposalIDVoteJSONRequestBody defines body for PutTripsTripIDProposalsProposalIDVote for application/json ContentType.
+type PutTripsTripIDProposalsProposalIDVoteJSONRequestBody PutTripsTripIDProposalsProposalIDVoteJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDProposalsProposalIDVoteJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDSettlementsJSONRequestBody defines body for PostTripsTripIDSettlements for application/json ContentType.
+type PostTripsTripIDSettlementsJSONRequestBody PostTripsTripIDSettlementsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDSettlementsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+}
+
+// Render implements the render.Renderer interface. It sets the Content-Type header
+// and status code based on the response definition.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", resp.contentType)
+	render.Status(r, resp.Code)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
 }
 
 // ContentType is a builder method to override the default content type for a response.
@@ -194,9 +1037,9 @@ func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	return e.Encode(resp.body)
 }
 
-// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// PostAuthMagicLinkJSON204Response is a constructor method for a PostAuthMagicLink response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
+func PostAuthMagicLinkJSON204Response(body interface{}) *Response {
 	return &Response{
 		body:        body,
 		Code:        204,
@@ -204,9 +1047,9 @@ func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Res
 	}
 }
 
-// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// PostAuthMagicLinkJSON400Response is a constructor method for a PostAuthMagicLink response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
+func PostAuthMagicLinkJSON400Response(body Error) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -214,9 +1057,81 @@ func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response
 	}
 }
 
-// PostTripsJSON201Response is a constructor method for a PostTrips response.
+// GetAuthVerifyTokenJSON200Response is a constructor method for a GetAuthVerifyToken response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON201Response(body CreateTripResponse) *Response {
+func GetAuthVerifyTokenJSON200Response(body struct {
+	Email openapi_types.Email `json:"email"`
+}) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthVerifyTokenJSON400Response is a constructor method for a GetAuthVerifyToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthVerifyTokenJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostAuthVerifyTokenJSON200Response is a constructor method for a PostAuthVerifyToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAuthVerifyTokenJSON200Response(body Session) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostAuthVerifyTokenJSON400Response is a constructor method for a PostAuthVerifyToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAuthVerifyTokenJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteCalendarFeedJSON204Response is a constructor method for a DeleteCalendarFeed response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteCalendarFeedJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteCalendarFeedJSON400Response is a constructor method for a DeleteCalendarFeed response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteCalendarFeedJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteCalendarFeedJSON401Response is a constructor method for a DeleteCalendarFeed response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteCalendarFeedJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostCalendarFeedJSON201Response is a constructor method for a PostCalendarFeed response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCalendarFeedJSON201Response(body CalendarFeed) *Response {
 	return &Response{
 		body:        body,
 		Code:        201,
@@ -224,9 +1139,9 @@ func PostTripsJSON201Response(body CreateTripResponse) *Response {
 	}
 }
 
-// PostTripsJSON400Response is a constructor method for a PostTrips response.
+// PostCalendarFeedJSON400Response is a constructor method for a PostCalendarFeed response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON400Response(body Error) *Response {
+func PostCalendarFeedJSON400Response(body Error) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -234,10 +1149,41 @@ func PostTripsJSON400Response(body Error) *Response {
 	}
 }
 
-// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
+// PostCalendarFeedJSON401Response is a constructor method for a PostCalendarFeed response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON200Response(body struct {
-	Trip Trip `json:"trip"`
+func PostCalendarFeedJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetCalendarFeedsTokenIcsJSON400Response is a constructor method for a GetCalendarFeedsTokenIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetCalendarFeedsTokenIcsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetCalendarFeedsTokenIcsJSON404Response is a constructor method for a GetCalendarFeedsTokenIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetCalendarFeedsTokenIcsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetConfirmationsParticipantsTokenJSON200Response is a constructor method for a GetConfirmationsParticipantsToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetConfirmationsParticipantsTokenJSON200Response(body struct {
+	Participant Participant `json:"participant"`
+	Trip        Trip        `json:"trip"`
 }) *Response {
 	return &Response{
 		body:        body,
@@ -246,9 +1192,9 @@ func GetTripsTripIDJSON200Response(body struct {
 	}
 }
 
-// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
+// GetConfirmationsParticipantsTokenJSON400Response is a constructor method for a GetConfirmationsParticipantsToken response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON400Response(body Error) *Response {
+func GetConfirmationsParticipantsTokenJSON400Response(body Error) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -256,9 +1202,9 @@ func GetTripsTripIDJSON400Response(body Error) *Response {
 	}
 }
 
-// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
+// PostConfirmationsParticipantsTokenJSON204Response is a constructor method for a PostConfirmationsParticipantsToken response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON204Response(body interface{}) *Response {
+func PostConfirmationsParticipantsTokenJSON204Response(body interface{}) *Response {
 	return &Response{
 		body:        body,
 		Code:        204,
@@ -266,9 +1212,9 @@ func PutTripsTripIDJSON204Response(body interface{}) *Response {
 	}
 }
 
-// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
+// PostConfirmationsParticipantsTokenJSON400Response is a constructor method for a PostConfirmationsParticipantsToken response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON400Response(body Error) *Response {
+func PostConfirmationsParticipantsTokenJSON400Response(body Error) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -276,9 +1222,9 @@ func PutTripsTripIDJSON400Response(body Error) *Response {
 	}
 }
 
-// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// GetConfirmationsTripsTokenJSON200Response is a constructor method for a GetConfirmationsTripsToken response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+func GetConfirmationsTripsTokenJSON200Response(body Trip) *Response {
 	return &Response{
 		body:        body,
 		Code:        200,
@@ -286,9 +1232,9 @@ func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Re
 	}
 }
 
-// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
+// GetConfirmationsTripsTokenJSON400Response is a constructor method for a GetConfirmationsTripsToken response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
+func GetConfirmationsTripsTokenJSON400Response(body Error) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -296,19 +1242,19 @@ func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
 	}
 }
 
-// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// PostConfirmationsTripsTokenJSON204Response is a constructor method for a PostConfirmationsTripsToken response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON201Response(body CreateTripActivitiesResponse) *Response {
+func PostConfirmationsTripsTokenJSON204Response(body interface{}) *Response {
 	return &Response{
 		body:        body,
-		Code:        201,
+		Code:        204,
 		contentType: "application/json",
 	}
 }
 
-// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
+// PostConfirmationsTripsTokenJSON400Response is a constructor method for a PostConfirmationsTripsToken response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
+func PostConfirmationsTripsTokenJSON400Response(body Error) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -316,9 +1262,9 @@ func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
 	}
 }
 
-// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
+// GetParticipantsParticipantIDConfirmJSON204Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+func GetParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
 	return &Response{
 		body:        body,
 		Code:        204,
@@ -326,9 +1272,39 @@ func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
 	}
 }
 
-// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
+// GetParticipantsParticipantIDConfirmJSON400Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
+func GetParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDConfirmJSON410Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDConfirmJSON410Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        410,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -336,147 +1312,3967 @@ func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
 	}
 }
 
-// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
-}
+// PatchParticipantsParticipantIDConfirmJSON410Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON410Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        410,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRsvpJSON204Response is a constructor method for a PatchParticipantsParticipantIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRsvpJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRsvpJSON400Response is a constructor method for a PatchParticipantsParticipantIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRsvpJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRsvpJSON401Response is a constructor method for a PatchParticipantsParticipantIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRsvpJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRsvpJSON403Response is a constructor method for a PatchParticipantsParticipantIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRsvpJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTemplatesJSON200Response is a constructor method for a GetTemplates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTemplatesJSON200Response(body ListTripTemplatesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTemplatesJSON400Response is a constructor method for a GetTemplates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTemplatesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTemplatesJSON401Response is a constructor method for a GetTemplates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTemplatesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTemplatesJSON201Response is a constructor method for a PostTemplates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTemplatesJSON201Response(body CreateTripTemplateResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTemplatesJSON400Response is a constructor method for a PostTemplates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTemplatesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTemplatesJSON401Response is a constructor method for a PostTemplates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTemplatesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTemplatesTemplateIDJSON204Response is a constructor method for a DeleteTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTemplatesTemplateIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTemplatesTemplateIDJSON400Response is a constructor method for a DeleteTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTemplatesTemplateIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTemplatesTemplateIDJSON401Response is a constructor method for a DeleteTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTemplatesTemplateIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTemplatesTemplateIDJSON403Response is a constructor method for a DeleteTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTemplatesTemplateIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTemplatesTemplateIDJSON200Response is a constructor method for a GetTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTemplatesTemplateIDJSON200Response(body TripTemplate) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTemplatesTemplateIDJSON400Response is a constructor method for a GetTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTemplatesTemplateIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTemplatesTemplateIDJSON401Response is a constructor method for a GetTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTemplatesTemplateIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTemplatesTemplateIDJSON403Response is a constructor method for a GetTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTemplatesTemplateIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTemplatesTemplateIDJSON204Response is a constructor method for a PutTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTemplatesTemplateIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTemplatesTemplateIDJSON400Response is a constructor method for a PutTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTemplatesTemplateIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTemplatesTemplateIDJSON401Response is a constructor method for a PutTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTemplatesTemplateIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTemplatesTemplateIDJSON403Response is a constructor method for a PutTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTemplatesTemplateIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON200Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON200Response(body ListTripsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON400Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON401Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON403Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON201Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON201Response(body CreateTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON400Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsImportJSON201Response is a constructor method for a PostTripsImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsImportJSON201Response(body ImportTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsImportJSON400Response is a constructor method for a PostTripsImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsImportJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsImportJSON401Response is a constructor method for a PostTripsImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsImportJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsImportJSON409Response is a constructor method for a PostTripsImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsImportJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON204Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON400Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON401Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON403Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON200Response(body struct {
+	// Budgets and expenses per category, in minor units of the trip's base currency. Expenses that couldn't be converted aren't part of the totals and are counted in unconverted_expenses.
+	Budget TripBudgetSummary `json:"budget"`
+	Trip   Trip              `json:"trip"`
+}) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON401Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON403Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON401Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON403Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON401Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON403Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON201Response(body CreateTripActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON401Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON403Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON401Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON401Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON401Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBalancesJSON200Response is a constructor method for a GetTripsTripIDBalances response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBalancesJSON200Response(body GetTripBalancesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBalancesJSON400Response is a constructor method for a GetTripsTripIDBalances response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBalancesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBalancesJSON401Response is a constructor method for a GetTripsTripIDBalances response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBalancesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBalancesJSON403Response is a constructor method for a GetTripsTripIDBalances response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBalancesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBudgetsJSON200Response is a constructor method for a GetTripsTripIDBudgets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBudgetsJSON200Response(body ListTripBudgetsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBudgetsJSON400Response is a constructor method for a GetTripsTripIDBudgets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBudgetsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBudgetsJSON401Response is a constructor method for a GetTripsTripIDBudgets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBudgetsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBudgetsJSON403Response is a constructor method for a GetTripsTripIDBudgets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBudgetsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDBudgetsJSON204Response is a constructor method for a PutTripsTripIDBudgets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDBudgetsJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDBudgetsJSON400Response is a constructor method for a PutTripsTripIDBudgets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDBudgetsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDBudgetsJSON401Response is a constructor method for a PutTripsTripIDBudgets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDBudgetsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDBudgetsJSON403Response is a constructor method for a PutTripsTripIDBudgets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDBudgetsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarIcsJSON400Response is a constructor method for a GetTripsTripIDCalendarIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarIcsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarIcsJSON401Response is a constructor method for a GetTripsTripIDCalendarIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarIcsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarIcsJSON403Response is a constructor method for a GetTripsTripIDCalendarIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarIcsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON204Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON400Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON401Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON403Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistsJSON200Response is a constructor method for a GetTripsTripIDChecklists response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistsJSON200Response(body ListTripChecklistsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistsJSON400Response is a constructor method for a GetTripsTripIDChecklists response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistsJSON401Response is a constructor method for a GetTripsTripIDChecklists response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistsJSON403Response is a constructor method for a GetTripsTripIDChecklists response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistsJSON201Response is a constructor method for a PostTripsTripIDChecklists response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistsJSON201Response(body CreateTripChecklistResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistsJSON400Response is a constructor method for a PostTripsTripIDChecklists response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistsJSON401Response is a constructor method for a PostTripsTripIDChecklists response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistsJSON403Response is a constructor method for a PostTripsTripIDChecklists response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDJSON204Response is a constructor method for a DeleteTripsTripIDChecklistsChecklistID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsChecklistIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDJSON400Response is a constructor method for a DeleteTripsTripIDChecklistsChecklistID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsChecklistIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDJSON401Response is a constructor method for a DeleteTripsTripIDChecklistsChecklistID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsChecklistIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDJSON403Response is a constructor method for a DeleteTripsTripIDChecklistsChecklistID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsChecklistIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDJSON204Response is a constructor method for a PutTripsTripIDChecklistsChecklistID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDJSON400Response is a constructor method for a PutTripsTripIDChecklistsChecklistID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDJSON401Response is a constructor method for a PutTripsTripIDChecklistsChecklistID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDJSON403Response is a constructor method for a PutTripsTripIDChecklistsChecklistID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistsChecklistIDItemsJSON201Response is a constructor method for a PostTripsTripIDChecklistsChecklistIDItems response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistsChecklistIDItemsJSON201Response(body CreateChecklistItemResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistsChecklistIDItemsJSON400Response is a constructor method for a PostTripsTripIDChecklistsChecklistIDItems response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistsChecklistIDItemsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistsChecklistIDItemsJSON401Response is a constructor method for a PostTripsTripIDChecklistsChecklistIDItems response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistsChecklistIDItemsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistsChecklistIDItemsJSON403Response is a constructor method for a PostTripsTripIDChecklistsChecklistIDItems response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistsChecklistIDItemsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response is a constructor method for a DeleteTripsTripIDChecklistsChecklistIDItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response is a constructor method for a DeleteTripsTripIDChecklistsChecklistIDItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON401Response is a constructor method for a DeleteTripsTripIDChecklistsChecklistIDItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON403Response is a constructor method for a DeleteTripsTripIDChecklistsChecklistIDItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response is a constructor method for a PutTripsTripIDChecklistsChecklistIDItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response is a constructor method for a PutTripsTripIDChecklistsChecklistIDItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON401Response is a constructor method for a PutTripsTripIDChecklistsChecklistIDItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON403Response is a constructor method for a PutTripsTripIDChecklistsChecklistIDItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDOrderJSON204Response is a constructor method for a PutTripsTripIDChecklistsChecklistIDOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDOrderJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDOrderJSON400Response is a constructor method for a PutTripsTripIDChecklistsChecklistIDOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDOrderJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDOrderJSON401Response is a constructor method for a PutTripsTripIDChecklistsChecklistIDOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDOrderJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDOrderJSON403Response is a constructor method for a PutTripsTripIDChecklistsChecklistIDOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDOrderJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCloneJSON201Response is a constructor method for a PostTripsTripIDClone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCloneJSON201Response(body CreateTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCloneJSON400Response is a constructor method for a PostTripsTripIDClone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCloneJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCloneJSON401Response is a constructor method for a PostTripsTripIDClone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCloneJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCloneJSON403Response is a constructor method for a PostTripsTripIDClone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCloneJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON410Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON410Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        410,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON200Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON200Response(body ListTripExpensesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON400Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON401Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON403Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON201Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON201Response(body CreateTripExpenseResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON400Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON401Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON403Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON204Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON400Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON401Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON403Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesExpenseIDJSON200Response is a constructor method for a GetTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesExpenseIDJSON200Response(body Expense) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesExpenseIDJSON400Response is a constructor method for a GetTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesExpenseIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesExpenseIDJSON401Response is a constructor method for a GetTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesExpenseIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesExpenseIDJSON403Response is a constructor method for a GetTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesExpenseIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDExpensesExpenseIDJSON204Response is a constructor method for a PutTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDExpensesExpenseIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDExpensesExpenseIDJSON400Response is a constructor method for a PutTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDExpensesExpenseIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDExpensesExpenseIDJSON401Response is a constructor method for a PutTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDExpensesExpenseIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDExpensesExpenseIDJSON403Response is a constructor method for a PutTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDExpensesExpenseIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExportJSON200Response is a constructor method for a GetTripsTripIDExport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExportJSON200Response(body TripBundle) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExportJSON400Response is a constructor method for a GetTripsTripIDExport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExportJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExportJSON401Response is a constructor method for a GetTripsTripIDExport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExportJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExportJSON403Response is a constructor method for a GetTripsTripIDExport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExportJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON401Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON403Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON200Response(body GetTripLinksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON401Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON403Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON201Response(body struct {
+	LinkID string `json:"linkId"`
+}) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON401Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON403Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON409Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON204Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON400Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON401Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON403Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON204Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON400Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON401Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON403Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON409Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON204Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON400Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON401Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON403Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON409Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON200Response(body struct {
+	Participants []Participant `json:"participants"`
+}) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON401Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON403Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON204Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON400Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON401Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON403Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDProposalsJSON201Response is a constructor method for a PostTripsTripIDProposals response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDProposalsJSON201Response(body CreateActivityProposalResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDProposalsJSON400Response is a constructor method for a PostTripsTripIDProposals response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDProposalsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDProposalsJSON401Response is a constructor method for a PostTripsTripIDProposals response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDProposalsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDProposalsJSON403Response is a constructor method for a PostTripsTripIDProposals response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDProposalsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDProposalsProposalIDJSON204Response is a constructor method for a DeleteTripsTripIDProposalsProposalID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDProposalsProposalIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDProposalsProposalIDJSON400Response is a constructor method for a DeleteTripsTripIDProposalsProposalID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDProposalsProposalIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDProposalsProposalIDJSON401Response is a constructor method for a DeleteTripsTripIDProposalsProposalID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDProposalsProposalIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDProposalsProposalIDJSON403Response is a constructor method for a DeleteTripsTripIDProposalsProposalID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDProposalsProposalIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDProposalsProposalIDPromoteJSON201Response is a constructor method for a PostTripsTripIDProposalsProposalIDPromote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDProposalsProposalIDPromoteJSON201Response(body PromoteActivityProposalResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDProposalsProposalIDPromoteJSON400Response is a constructor method for a PostTripsTripIDProposalsProposalIDPromote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDProposalsProposalIDPromoteJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDProposalsProposalIDPromoteJSON401Response is a constructor method for a PostTripsTripIDProposalsProposalIDPromote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDProposalsProposalIDPromoteJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDProposalsProposalIDPromoteJSON403Response is a constructor method for a PostTripsTripIDProposalsProposalIDPromote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDProposalsProposalIDPromoteJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDProposalsProposalIDVoteJSON204Response is a constructor method for a DeleteTripsTripIDProposalsProposalIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDProposalsProposalIDVoteJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDProposalsProposalIDVoteJSON400Response is a constructor method for a DeleteTripsTripIDProposalsProposalIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDProposalsProposalIDVoteJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDProposalsProposalIDVoteJSON401Response is a constructor method for a DeleteTripsTripIDProposalsProposalIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDProposalsProposalIDVoteJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDProposalsProposalIDVoteJSON403Response is a constructor method for a DeleteTripsTripIDProposalsProposalIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDProposalsProposalIDVoteJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDProposalsProposalIDVoteJSON204Response is a constructor method for a PutTripsTripIDProposalsProposalIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDProposalsProposalIDVoteJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDProposalsProposalIDVoteJSON400Response is a constructor method for a PutTripsTripIDProposalsProposalIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDProposalsProposalIDVoteJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDProposalsProposalIDVoteJSON401Response is a constructor method for a PutTripsTripIDProposalsProposalIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDProposalsProposalIDVoteJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDProposalsProposalIDVoteJSON403Response is a constructor method for a PutTripsTripIDProposalsProposalIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDProposalsProposalIDVoteJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSettlementsJSON200Response is a constructor method for a GetTripsTripIDSettlements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSettlementsJSON200Response(body ListTripSettlementsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSettlementsJSON400Response is a constructor method for a GetTripsTripIDSettlements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSettlementsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSettlementsJSON401Response is a constructor method for a GetTripsTripIDSettlements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSettlementsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSettlementsJSON403Response is a constructor method for a GetTripsTripIDSettlements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSettlementsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSettlementsJSON201Response is a constructor method for a PostTripsTripIDSettlements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSettlementsJSON201Response(body CreateTripSettlementResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSettlementsJSON400Response is a constructor method for a PostTripsTripIDSettlements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSettlementsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSettlementsJSON401Response is a constructor method for a PostTripsTripIDSettlements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSettlementsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSettlementsJSON403Response is a constructor method for a PostTripsTripIDSettlements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSettlementsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDSettlementsSettlementIDJSON204Response is a constructor method for a DeleteTripsTripIDSettlementsSettlementID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDSettlementsSettlementIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDSettlementsSettlementIDJSON400Response is a constructor method for a DeleteTripsTripIDSettlementsSettlementID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDSettlementsSettlementIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDSettlementsSettlementIDJSON401Response is a constructor method for a DeleteTripsTripIDSettlementsSettlementID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDSettlementsSettlementIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDSettlementsSettlementIDJSON403Response is a constructor method for a DeleteTripsTripIDSettlementsSettlementID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDSettlementsSettlementIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Send a sign in link to the given e-mail.
+	// (POST /auth/magic-link)
+	PostAuthMagicLink(w http.ResponseWriter, r *http.Request) *Response
+	// Show the e-mail a sign in link was sent to, without using it.
+	// (GET /auth/verify/{token})
+	GetAuthVerifyToken(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Exchange a sign in link for a session.
+	// (POST /auth/verify/{token})
+	PostAuthVerifyToken(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Revoke the calendar feed of the authenticated user.
+	// (DELETE /calendar/feed)
+	DeleteCalendarFeed(w http.ResponseWriter, r *http.Request) *Response
+	// Create or rotate the calendar feed of the authenticated user.
+	// (POST /calendar/feed)
+	PostCalendarFeed(w http.ResponseWriter, r *http.Request) *Response
+	// Subscribe to a calendar feed.
+	// (GET /calendar/feeds/{token}.ics)
+	GetCalendarFeedsTokenIcs(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Show the invitation a signed confirmation link refers to, without confirming it.
+	// (GET /confirmations/participants/{token})
+	GetConfirmationsParticipantsToken(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Confirms a participant on a trip through the signed link sent on the invitation e-mail.
+	// (POST /confirmations/participants/{token})
+	PostConfirmationsParticipantsToken(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Show the trip a signed confirmation link refers to, without confirming it.
+	// (GET /confirmations/trips/{token})
+	GetConfirmationsTripsToken(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Confirm a trip through the signed link sent to its owner.
+	// (POST /confirmations/trips/{token})
+	PostConfirmationsTripsToken(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Confirms a participant on a trip through the link sent on the invitation e-mail.
+	// (GET /participants/{participantId}/confirm)
+	GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Confirms a participant on a trip.
+	// (PATCH /participants/{participantId}/confirm)
+	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Answers a trip invitation.
+	// (PATCH /participants/{participantId}/rsvp)
+	PatchParticipantsParticipantIDRsvp(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// List the trip templates of the authenticated user.
+	// (GET /templates)
+	GetTemplates(w http.ResponseWriter, r *http.Request) *Response
+	// Create a trip template.
+	// (POST /templates)
+	PostTemplates(w http.ResponseWriter, r *http.Request) *Response
+	// Delete a trip template.
+	// (DELETE /templates/{templateId})
+	DeleteTemplatesTemplateID(w http.ResponseWriter, r *http.Request, templateID string) *Response
+	// Get a trip template.
+	// (GET /templates/{templateId})
+	GetTemplatesTemplateID(w http.ResponseWriter, r *http.Request, templateID string) *Response
+	// Replace a trip template.
+	// (PUT /templates/{templateId})
+	PutTemplatesTemplateID(w http.ResponseWriter, r *http.Request, templateID string) *Response
+	// List the trips of the authenticated user.
+	// (GET /trips)
+	GetTrips(w http.ResponseWriter, r *http.Request, params GetTripsParams) *Response
+	// Create a new trip
+	// (POST /trips)
+	PostTrips(w http.ResponseWriter, r *http.Request) *Response
+	// Import a trip from a JSON bundle.
+	// (POST /trips/import)
+	PostTripsImport(w http.ResponseWriter, r *http.Request) *Response
+	// Delete a trip.
+	// (DELETE /trips/{tripId})
+	DeleteTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip details.
+	// (GET /trips/{tripId})
+	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Update a trip.
+	// (PUT /trips/{tripId})
+	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params PutTripsTripIDParams) *Response
+	// Get a trip activities.
+	// (GET /trips/{tripId}/activities)
+	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesParams) *Response
+	// Create a trip activity.
+	// (POST /trips/{tripId}/activities)
+	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip activity.
+	// (DELETE /trips/{tripId}/activities/{activityId})
+	DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Partially update a trip activity.
+	// (PATCH /trips/{tripId}/activities/{activityId})
+	PatchTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Update a trip activity.
+	// (PUT /trips/{tripId}/activities/{activityId})
+	PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Get the balance of each member and the transfers that settle up the trip.
+	// (GET /trips/{tripId}/balances)
+	GetTripsTripIDBalances(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// List the trip budgets.
+	// (GET /trips/{tripId}/budgets)
+	GetTripsTripIDBudgets(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Replace the trip budgets.
+	// (PUT /trips/{tripId}/budgets)
+	PutTripsTripIDBudgets(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Export the trip itinerary as an iCalendar file.
+	// (GET /trips/{tripId}/calendar.ics)
+	GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Cancel a trip.
+	// (POST /trips/{tripId}/cancel)
+	PostTripsTripIDCancel(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// List the trip checklists and their items.
+	// (GET /trips/{tripId}/checklists)
+	GetTripsTripIDChecklists(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip checklist.
+	// (POST /trips/{tripId}/checklists)
+	PostTripsTripIDChecklists(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip checklist and its items.
+	// (DELETE /trips/{tripId}/checklists/{checklistId})
+	DeleteTripsTripIDChecklistsChecklistID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *Response
+	// Update a trip checklist.
+	// (PUT /trips/{tripId}/checklists/{checklistId})
+	PutTripsTripIDChecklistsChecklistID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *Response
+	// Add an item to the end of a checklist.
+	// (POST /trips/{tripId}/checklists/{checklistId}/items)
+	PostTripsTripIDChecklistsChecklistIDItems(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *Response
+	// Delete a checklist item.
+	// (DELETE /trips/{tripId}/checklists/{checklistId}/items/{itemId})
+	DeleteTripsTripIDChecklistsChecklistIDItemsItemID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string, itemID string) *Response
+	// Update a checklist item.
+	// (PUT /trips/{tripId}/checklists/{checklistId}/items/{itemId})
+	PutTripsTripIDChecklistsChecklistIDItemsItemID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string, itemID string) *Response
+	// Reorder the items of a checklist.
+	// (PUT /trips/{tripId}/checklists/{checklistId}/order)
+	PutTripsTripIDChecklistsChecklistIDOrder(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *Response
+	// Clone a trip.
+	// (POST /trips/{tripId}/clone)
+	PostTripsTripIDClone(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Confirm a trip and send e-mail invitations.
+	// (GET /trips/{tripId}/confirm)
+	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// List the trip expenses.
+	// (GET /trips/{tripId}/expenses)
+	GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Add an expense to the trip.
+	// (POST /trips/{tripId}/expenses)
+	PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip expense.
+	// (DELETE /trips/{tripId}/expenses/{expenseId})
+	DeleteTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string) *Response
+	// Get a trip expense.
+	// (GET /trips/{tripId}/expenses/{expenseId})
+	GetTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string) *Response
+	// Replace a trip expense.
+	// (PUT /trips/{tripId}/expenses/{expenseId})
+	PutTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string) *Response
+	// Export the trip as a JSON bundle.
+	// (GET /trips/{tripId}/export)
+	GetTripsTripIDExport(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Invite someone to the trip.
+	// (POST /trips/{tripId}/invites)
+	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip links.
+	// (GET /trips/{tripId}/links)
+	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip link.
+	// (POST /trips/{tripId}/links)
+	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip link.
+	// (DELETE /trips/{tripId}/links/{linkId})
+	DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Partially update a trip link.
+	// (PATCH /trips/{tripId}/links/{linkId})
+	PatchTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Update a trip link.
+	// (PUT /trips/{tripId}/links/{linkId})
+	PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Get a trip participants.
+	// (GET /trips/{tripId}/participants)
+	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove a participant from the trip.
+	// (DELETE /trips/{tripId}/participants/{participantId})
+	DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string, params DeleteTripsTripIDParticipantsParticipantIDParams) *Response
+	// Propose an activity to the trip members.
+	// (POST /trips/{tripId}/proposals)
+	PostTripsTripIDProposals(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete an activity proposal.
+	// (DELETE /trips/{tripId}/proposals/{proposalId})
+	DeleteTripsTripIDProposalsProposalID(w http.ResponseWriter, r *http.Request, tripID string, proposalID string) *Response
+	// Promote an activity proposal to a scheduled activity.
+	// (POST /trips/{tripId}/proposals/{proposalId}/promote)
+	PostTripsTripIDProposalsProposalIDPromote(w http.ResponseWriter, r *http.Request, tripID string, proposalID string) *Response
+	// Withdraw a vote on an activity proposal.
+	// (DELETE /trips/{tripId}/proposals/{proposalId}/vote)
+	DeleteTripsTripIDProposalsProposalIDVote(w http.ResponseWriter, r *http.Request, tripID string, proposalID string) *Response
+	// Vote on an activity proposal.
+	// (PUT /trips/{tripId}/proposals/{proposalId}/vote)
+	PutTripsTripIDProposalsProposalIDVote(w http.ResponseWriter, r *http.Request, tripID string, proposalID string) *Response
+	// List the payments recorded to settle up the trip.
+	// (GET /trips/{tripId}/settlements)
+	GetTripsTripIDSettlements(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Record a payment made to settle up the trip.
+	// (POST /trips/{tripId}/settlements)
+	PostTripsTripIDSettlements(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a settlement payment recorded by mistake.
+	// (DELETE /trips/{tripId}/settlements/{settlementId})
+	DeleteTripsTripIDSettlementsSettlementID(w http.ResponseWriter, r *http.Request, tripID string, settlementID string) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// PostAuthMagicLink operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthMagicLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAuthMagicLink(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAuthVerifyToken operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthVerifyToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAuthVerifyToken(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostAuthVerifyToken operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthVerifyToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAuthVerifyToken(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteCalendarFeed(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) PostCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCalendarFeed(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetCalendarFeedsTokenIcs operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarFeedsTokenIcs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetCalendarFeedsTokenIcs(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetConfirmationsParticipantsToken operation middleware
+func (siw *ServerInterfaceWrapper) GetConfirmationsParticipantsToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetConfirmationsParticipantsToken(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostConfirmationsParticipantsToken operation middleware
+func (siw *ServerInterfaceWrapper) PostConfirmationsParticipantsToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostConfirmationsParticipantsToken(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetConfirmationsTripsToken operation middleware
+func (siw *ServerInterfaceWrapper) GetConfirmationsTripsToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetConfirmationsTripsToken(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostConfirmationsTripsToken operation middleware
+func (siw *ServerInterfaceWrapper) PostConfirmationsTripsToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostConfirmationsTripsToken(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDConfirm(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDRsvp operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDRsvp(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDRsvp(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTemplates(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTemplates operation middleware
+func (siw *ServerInterfaceWrapper) PostTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTemplates(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTemplatesTemplateID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTemplatesTemplateID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "templateId" -------------
+	var templateID string
+
+	if err := runtime.BindStyledParameter("simple", false, "templateId", chi.URLParam(r, "templateId"), &templateID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "templateId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTemplatesTemplateID(w, r, templateID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTemplatesTemplateID operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplatesTemplateID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "templateId" -------------
+	var templateID string
+
+	if err := runtime.BindStyledParameter("simple", false, "templateId", chi.URLParam(r, "templateId"), &templateID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "templateId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTemplatesTemplateID(w, r, templateID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTemplatesTemplateID operation middleware
+func (siw *ServerInterfaceWrapper) PutTemplatesTemplateID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "templateId" -------------
+	var templateID string
+
+	if err := runtime.BindStyledParameter("simple", false, "templateId", chi.URLParam(r, "templateId"), &templateID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "templateId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTemplatesTemplateID(w, r, templateID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTrips operation middleware
+func (siw *ServerInterfaceWrapper) GetTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsParams
+
+	// ------------- Optional query parameter "owner_email" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "owner_email", r.URL.Query(), &params.OwnerEmail); err != nil {
+		err = fmt.Errorf("invalid format for parameter owner_email: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "owner_email"})
+		return
+	}
+
+	// ------------- Optional query parameter "participant_email" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "participant_email", r.URL.Query(), &params.ParticipantEmail); err != nil {
+		err = fmt.Errorf("invalid format for parameter participant_email: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participant_email"})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status); err != nil {
+		err = fmt.Errorf("invalid format for parameter status: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "status"})
+		return
+	}
+
+	// ------------- Optional query parameter "starts_after" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "starts_after", r.URL.Query(), &params.StartsAfter); err != nil {
+		err = fmt.Errorf("invalid format for parameter starts_after: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "starts_after"})
+		return
+	}
+
+	// ------------- Optional query parameter "ends_before" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ends_before", r.URL.Query(), &params.EndsBefore); err != nil {
+		err = fmt.Errorf("invalid format for parameter ends_before: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ends_before"})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort); err != nil {
+		err = fmt.Errorf("invalid format for parameter sort: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sort"})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor); err != nil {
+		err = fmt.Errorf("invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cursor"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTrips(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTrips operation middleware
+func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTrips(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsImport operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsImport(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDParams
+
+	// ------------- Optional query parameter "activities" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "activities", r.URL.Query(), &params.Activities); err != nil {
+		err = fmt.Errorf("invalid format for parameter activities: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activities"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripID(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesParams
+
+	// ------------- Optional query parameter "group" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "group", r.URL.Query(), &params.Group); err != nil {
+		err = fmt.Errorf("invalid format for parameter group: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "group"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDBalances operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDBalances(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDBalances(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDBudgets operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDBudgets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDBudgets(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDBudgets operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDBudgets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDBudgets(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDCalendarIcs operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDCalendarIcs(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDCancel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDCancel(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDChecklists operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDChecklists(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDChecklists(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDChecklists operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDChecklists(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDChecklists(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDChecklistsChecklistID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDChecklistsChecklistID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDChecklistsChecklistID(w, r, tripID, checklistID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDChecklistsChecklistID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDChecklistsChecklistID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDChecklistsChecklistID(w, r, tripID, checklistID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDChecklistsChecklistIDItems operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDChecklistsChecklistIDItems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDChecklistsChecklistIDItems(w, r, tripID, checklistID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDItemsItemID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDChecklistsChecklistIDItemsItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDChecklistsChecklistIDItemsItemID(w, r, tripID, checklistID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDChecklistsChecklistIDItemsItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDChecklistsChecklistIDItemsItemID(w, r, tripID, checklistID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDChecklistsChecklistIDOrder operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDChecklistsChecklistIDOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDChecklistsChecklistIDOrder(w, r, tripID, checklistID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDClone operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDClone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDClone(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpenses operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpenses(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDExpenses operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDExpenses(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDExpensesExpenseID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "expenseId" -------------
+	var expenseID string
+
+	if err := runtime.BindStyledParameter("simple", false, "expenseId", chi.URLParam(r, "expenseId"), &expenseID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "expenseId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDExpensesExpenseID(w, r, tripID, expenseID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpensesExpenseID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "expenseId" -------------
+	var expenseID string
+
+	if err := runtime.BindStyledParameter("simple", false, "expenseId", chi.URLParam(r, "expenseId"), &expenseID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "expenseId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpensesExpenseID(w, r, tripID, expenseID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDExpensesExpenseID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "expenseId" -------------
+	var expenseID string
+
+	if err := runtime.BindStyledParameter("simple", false, "expenseId", chi.URLParam(r, "expenseId"), &expenseID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "expenseId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDExpensesExpenseID(w, r, tripID, expenseID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExport operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExport(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
-}
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON200Response(body GetTripLinksResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON201Response(body struct {
-	LinkID string `json:"linkId"`
-}) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
+// DeleteTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
 	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDLinksLinkID(w, r, tripID, linkID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON200Response(body struct {
-	Participants []Participant `json:"participants"`
-}) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+// PatchTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
 	}
-}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Confirms a participant on a trip.
-	// (PATCH /participants/{participantId}/confirm)
-	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
-	// Create a new trip
-	// (POST /trips)
-	PostTrips(w http.ResponseWriter, r *http.Request) *Response
-	// Get a trip details.
-	// (GET /trips/{tripId})
-	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Update a trip.
-	// (PUT /trips/{tripId})
-	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip activities.
-	// (GET /trips/{tripId}/activities)
-	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip activity.
-	// (POST /trips/{tripId}/activities)
-	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Confirm a trip and send e-mail invitations.
-	// (GET /trips/{tripId}/confirm)
-	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Invite someone to the trip.
-	// (POST /trips/{tripId}/invites)
-	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip links.
-	// (GET /trips/{tripId}/links)
-	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip link.
-	// (POST /trips/{tripId}/links)
-	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip participants.
-	// (GET /trips/{tripId}/participants)
-	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
-}
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDLinksLinkID(w, r, tripID, linkID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler          ServerInterface
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler(w, r.WithContext(ctx))
 }
 
-// PatchParticipantsParticipantIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+// PutTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "participantId" -------------
-	var participantID string
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
 		return
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		resp := siw.Handler.PutTripsTripIDLinksLinkID(w, r, tripID, linkID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -489,12 +5285,20 @@ func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTrips operation middleware
-func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTrips(w, r)
+		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -507,8 +5311,8 @@ func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDParticipantsParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -519,8 +5323,27 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDParticipantsParticipantIDParams
+
+	// ------------- Optional query parameter "notify" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "notify", r.URL.Query(), &params.Notify); err != nil {
+		err = fmt.Errorf("invalid format for parameter notify: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "notify"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDParticipantsParticipantID(w, r, tripID, participantID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -533,8 +5356,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// PutTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDProposals operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDProposals(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -546,7 +5369,7 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDProposals(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -559,8 +5382,8 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDProposalsProposalID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDProposalsProposalID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -571,8 +5394,16 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 		return
 	}
 
+	// ------------- Path parameter "proposalId" -------------
+	var proposalID string
+
+	if err := runtime.BindStyledParameter("simple", false, "proposalId", chi.URLParam(r, "proposalId"), &proposalID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "proposalId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDProposalsProposalID(w, r, tripID, proposalID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -585,8 +5416,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDProposalsProposalIDPromote operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDProposalsProposalIDPromote(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -597,8 +5428,16 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 		return
 	}
 
+	// ------------- Path parameter "proposalId" -------------
+	var proposalID string
+
+	if err := runtime.BindStyledParameter("simple", false, "proposalId", chi.URLParam(r, "proposalId"), &proposalID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "proposalId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDProposalsProposalIDPromote(w, r, tripID, proposalID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -611,8 +5450,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDProposalsProposalIDVote operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDProposalsProposalIDVote(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -623,8 +5462,16 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "proposalId" -------------
+	var proposalID string
+
+	if err := runtime.BindStyledParameter("simple", false, "proposalId", chi.URLParam(r, "proposalId"), &proposalID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "proposalId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDProposalsProposalIDVote(w, r, tripID, proposalID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -637,8 +5484,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDInvites operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+// PutTripsTripIDProposalsProposalIDVote operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDProposalsProposalIDVote(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -649,8 +5496,16 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "proposalId" -------------
+	var proposalID string
+
+	if err := runtime.BindStyledParameter("simple", false, "proposalId", chi.URLParam(r, "proposalId"), &proposalID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "proposalId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		resp := siw.Handler.PutTripsTripIDProposalsProposalIDVote(w, r, tripID, proposalID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -663,8 +5518,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDSettlements operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSettlements(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -676,7 +5531,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDSettlements(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -689,8 +5544,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDSettlements operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDSettlements(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -702,7 +5557,7 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDSettlements(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -715,8 +5570,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipants operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDSettlementsSettlementID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDSettlementsSettlementID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -727,8 +5582,16 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWri
 		return
 	}
 
+	// ------------- Path parameter "settlementId" -------------
+	var settlementID string
+
+	if err := runtime.BindStyledParameter("simple", false, "settlementId", chi.URLParam(r, "settlementId"), &settlementID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "settlementId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDSettlementsSettlementID(w, r, tripID, settlementID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -856,17 +5719,72 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Post("/auth/magic-link", wrapper.PostAuthMagicLink)
+		r.Get("/auth/verify/{token}", wrapper.GetAuthVerifyToken)
+		r.Post("/auth/verify/{token}", wrapper.PostAuthVerifyToken)
+		r.Delete("/calendar/feed", wrapper.DeleteCalendarFeed)
+		r.Post("/calendar/feed", wrapper.PostCalendarFeed)
+		r.Get("/calendar/feeds/{token}.ics", wrapper.GetCalendarFeedsTokenIcs)
+		r.Get("/confirmations/participants/{token}", wrapper.GetConfirmationsParticipantsToken)
+		r.Post("/confirmations/participants/{token}", wrapper.PostConfirmationsParticipantsToken)
+		r.Get("/confirmations/trips/{token}", wrapper.GetConfirmationsTripsToken)
+		r.Post("/confirmations/trips/{token}", wrapper.PostConfirmationsTripsToken)
+		r.Get("/participants/{participantId}/confirm", wrapper.GetParticipantsParticipantIDConfirm)
 		r.Patch("/participants/{participantId}/confirm", wrapper.PatchParticipantsParticipantIDConfirm)
+		r.Patch("/participants/{participantId}/rsvp", wrapper.PatchParticipantsParticipantIDRsvp)
+		r.Get("/templates", wrapper.GetTemplates)
+		r.Post("/templates", wrapper.PostTemplates)
+		r.Delete("/templates/{templateId}", wrapper.DeleteTemplatesTemplateID)
+		r.Get("/templates/{templateId}", wrapper.GetTemplatesTemplateID)
+		r.Put("/templates/{templateId}", wrapper.PutTemplatesTemplateID)
+		r.Get("/trips", wrapper.GetTrips)
 		r.Post("/trips", wrapper.PostTrips)
+		r.Post("/trips/import", wrapper.PostTripsImport)
+		r.Delete("/trips/{tripId}", wrapper.DeleteTripsTripID)
 		r.Get("/trips/{tripId}", wrapper.GetTripsTripID)
 		r.Put("/trips/{tripId}", wrapper.PutTripsTripID)
 		r.Get("/trips/{tripId}/activities", wrapper.GetTripsTripIDActivities)
 		r.Post("/trips/{tripId}/activities", wrapper.PostTripsTripIDActivities)
+		r.Delete("/trips/{tripId}/activities/{activityId}", wrapper.DeleteTripsTripIDActivitiesActivityID)
+		r.Patch("/trips/{tripId}/activities/{activityId}", wrapper.PatchTripsTripIDActivitiesActivityID)
+		r.Put("/trips/{tripId}/activities/{activityId}", wrapper.PutTripsTripIDActivitiesActivityID)
+		r.Get("/trips/{tripId}/balances", wrapper.GetTripsTripIDBalances)
+		r.Get("/trips/{tripId}/budgets", wrapper.GetTripsTripIDBudgets)
+		r.Put("/trips/{tripId}/budgets", wrapper.PutTripsTripIDBudgets)
+		r.Get("/trips/{tripId}/calendar.ics", wrapper.GetTripsTripIDCalendarIcs)
+		r.Post("/trips/{tripId}/cancel", wrapper.PostTripsTripIDCancel)
+		r.Get("/trips/{tripId}/checklists", wrapper.GetTripsTripIDChecklists)
+		r.Post("/trips/{tripId}/checklists", wrapper.PostTripsTripIDChecklists)
+		r.Delete("/trips/{tripId}/checklists/{checklistId}", wrapper.DeleteTripsTripIDChecklistsChecklistID)
+		r.Put("/trips/{tripId}/checklists/{checklistId}", wrapper.PutTripsTripIDChecklistsChecklistID)
+		r.Post("/trips/{tripId}/checklists/{checklistId}/items", wrapper.PostTripsTripIDChecklistsChecklistIDItems)
+		r.Delete("/trips/{tripId}/checklists/{checklistId}/items/{itemId}", wrapper.DeleteTripsTripIDChecklistsChecklistIDItemsItemID)
+		r.Put("/trips/{tripId}/checklists/{checklistId}/items/{itemId}", wrapper.PutTripsTripIDChecklistsChecklistIDItemsItemID)
+		r.Put("/trips/{tripId}/checklists/{checklistId}/order", wrapper.PutTripsTripIDChecklistsChecklistIDOrder)
+		r.Post("/trips/{tripId}/clone", wrapper.PostTripsTripIDClone)
 		r.Get("/trips/{tripId}/confirm", wrapper.GetTripsTripIDConfirm)
+		r.Get("/trips/{tripId}/expenses", wrapper.GetTripsTripIDExpenses)
+		r.Post("/trips/{tripId}/expenses", wrapper.PostTripsTripIDExpenses)
+		r.Delete("/trips/{tripId}/expenses/{expenseId}", wrapper.DeleteTripsTripIDExpensesExpenseID)
+		r.Get("/trips/{tripId}/expenses/{expenseId}", wrapper.GetTripsTripIDExpensesExpenseID)
+		r.Put("/trips/{tripId}/expenses/{expenseId}", wrapper.PutTripsTripIDExpensesExpenseID)
+		r.Get("/trips/{tripId}/export", wrapper.GetTripsTripIDExport)
 		r.Post("/trips/{tripId}/invites", wrapper.PostTripsTripIDInvites)
 		r.Get("/trips/{tripId}/links", wrapper.GetTripsTripIDLinks)
 		r.Post("/trips/{tripId}/links", wrapper.PostTripsTripIDLinks)
+		r.Delete("/trips/{tripId}/links/{linkId}", wrapper.DeleteTripsTripIDLinksLinkID)
+		r.Patch("/trips/{tripId}/links/{linkId}", wrapper.PatchTripsTripIDLinksLinkID)
+		r.Put("/trips/{tripId}/links/{linkId}", wrapper.PutTripsTripIDLinksLinkID)
 		r.Get("/trips/{tripId}/participants", wrapper.GetTripsTripIDParticipants)
+		r.Delete("/trips/{tripId}/participants/{participantId}", wrapper.DeleteTripsTripIDParticipantsParticipantID)
+		r.Post("/trips/{tripId}/proposals", wrapper.PostTripsTripIDProposals)
+		r.Delete("/trips/{tripId}/proposals/{proposalId}", wrapper.DeleteTripsTripIDProposalsProposalID)
+		r.Post("/trips/{tripId}/proposals/{proposalId}/promote", wrapper.PostTripsTripIDProposalsProposalIDPromote)
+		r.Delete("/trips/{tripId}/proposals/{proposalId}/vote", wrapper.DeleteTripsTripIDProposalsProposalIDVote)
+		r.Put("/trips/{tripId}/proposals/{proposalId}/vote", wrapper.PutTripsTripIDProposalsProposalIDVote)
+		r.Get("/trips/{tripId}/settlements", wrapper.GetTripsTripIDSettlements)
+		r.Post("/trips/{tripId}/settlements", wrapper.PostTripsTripIDSettlements)
+		r.Delete("/trips/{tripId}/settlements/{settlementId}", wrapper.DeleteTripsTripIDSettlementsSettlementID)
 	})
 	return r
 }
@@ -892,29 +5810,157 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa3W7bNhR+FYLbpRy7W64E7KJ/KDwUQ1B02EVRBIx0bLOVSI08cmoYeppd7GqXe4K8",
-	"2HBIWaYS2ZaduIub3iQ2zZ/z+32HR1ryROeFVqDQ8njJbTKDXLiPLw0IhPdGFs8TlHOJEuw7sIVWFuh3",
-	"kaYSpVYiuzC6AEO/83giMgsRL4KhJRd+g8U4pW8TbXKBPOZlKVMecVwUwGNu0Ug15VUVcQN/ltJAyuMP",
-	"4dqPzVx99QkS5FUUSHmgbGhkcYhc9boumV4bo81OMVKwiZEF/c5j/kKkjLYHi/y2iDlYK6ZOsbZMEf8y",
-	"mOoBfEEjBiimbvZcZDIVSNMaeavb0q+27BL/DWDb62OlwOxpVtnDpH3Fj9xqUkEnSWnsJW0ZbE7TByhz",
-	"OPgEtzlKzB7UyF5lt2soei+b3y/TVk5AyN2HHw1MeMx/GK6zfVin+nCDu6tGSmGMWGzKSzppi0Jvpfp8",
-	"qC4Zre2tBp20U2i/ZZe8bvnDh/jmsKoiXpqsvYORO2GnFVO0QZcyF8KgTGQhFO6pE+RCtoXyIx16efXv",
-	"DtvLRKuJNDmEE660zkAomqFE7uyhyiwTV2QbNCX0UtwtjRqZWmd1GYJCcE8LpGBRKuFheclzqd6CmuKM",
-	"x+cH41cu1S/nTiNQ6bHg6ziIu8OdvTds/F9F3KIweBwzdAVN6NHw8LU3dkaSs4Sa6DpAAt5+bQtI5EQm",
-	"4ubvm3/BslSw5xdjVggjmGZXIvk8AJXSsCgyP+0vzYpMKHUGhiVaWTTlzT+pYGlphEJgmv329g/2qy6N",
-	"ggWtfKeTz4AWBJ412R/z1R484nMw1svz7Gx0NqLQ1wUoUUge85/dUMQLgTNnumGxxgc7XAbfxmk1rO1A",
-	"EwuByYw+UII4A1KlxC9oOMAYG3wev3pZr6cDjcgBwVgef1hySfKREKtEpm/B0Tz0nYcED+4PH9fVRzrL",
-	"s5Kb+9PonP4lWiHUoFk4d5HSw0/Wg8FaHFBlTgFGGEbx0sYyFy/tOHkFE1FmyBourCJ+Phrtdeg2+vM1",
-	"Z8fBYWHpkq/Mc2EWPOa1oywTLPAD04oJRtWtizVnyw+hoyz/SPsMaYqzXaEtdgSJtq4CsLVbweILnS72",
-	"UvjxwDYRjr1EfSnVXCK0qpIdVFkf5weXHSzXqlh6C5fKOUT+xCMTi75WYC77Vga9Fahl32kef/yqaLiH",
-	"Hl+Pdvowzp2Qamnatno3IbXBsrqDaM8eDFw6LtknAnFOcCaYgmuHaQGkefwKsGy49Hf6igSZQgem1Zca",
-	"S3/Gr3oxXN0m+F+pbXQs0MW6vN7mNVeCdzVPNkT1o4+pN4A1QbIUkJL4rCOqIl6UXaxYnkoEnTpjH48P",
-	"HxOPHMYMT6/W/b1IPRHcLmw3s8Cw3UurCaF94PuZtMzoEoFdyyxjBrA0ioksYzgDRmdadgV4DaDciION",
-	"xpFMqJTVrvSTIwZzN1Vb2hJnukS2FoQk30ZJ6ybeN0pOezUyT6xYCYil7fFVrIYtVyKY7feuU4qIo5PN",
-	"aT04WEu72vzx3ABONr2au0CYYYuN+dVBCEFnrMf1YJ8+2NHT7nvf607fq4kElTILRMQDumwzdx13otie",
-	"hYK/wPfphfnIGNfznzIgH6uddBtKv14D5VtIDR+ZzOoctAKGuila+zSD1xnRPLrtgZPuEfHTLVfbT8hP",
-	"rlJ1ng6Do37E3rc+ffTePzoS3r967PcmQX/1jbyLo9tfOXh4NN3zPZFD3uCq151oG7JdzpIuXVnYgc0t",
-	"9O4H0eGz3u9d79sG7PV6UvhKzq63lNr8evJd8lCdbWVEVf0XAAD//1qe9sSOKgAA",
+	"H4sIAAAAAAAC/+x9XY/jtrLgXyG8C+ReQNM9k8w5e04DeZjMdLJ9kMwM5iPBwUXQoKVym7clUiEpe3wH",
+	"/Wv2YZ/2cX/B+WMXxQ+JsiVbku1ud1p5yLhtiSySVcX6rq+TWGS54MC1mlx8nah4Dhk1H1/Fmi2YXr2X",
+	"IheKpvgdTRKmmeA0xW9BagZqcjGjqYJokoCKJcvx98nF5BXJzYuQEOpGIpQnhGlFJKgi1eqM3DL8RpGF",
+	"0ECEJLlI08g8pjTVhcLfymEKrllK9ByIWHKQ+EMmNCjCNNHC/FDOxHj5+ZolZyRbXZs5cOhsdS0MlNcs",
+	"IXORJuZVC8LMDlPoOXDNYqohIRlkU5ARYTNC+epsEk3yYPFfJ8FE+OdMyIzqycWkKFgyiSa8SFM6TWFy",
+	"oWUB0USvcphcTJSWjN9M7qJJLAHnuaa69npCNTzTLINJwzuJWHKE2ADgfmVcww1I/LkZko1RcPuDAaof",
+	"wi0atCa33/juzmdFHBdSbVt+9yGuUxHTdI+BzKrNrjINmfnwPyXMJheT/3Fekcq5o5PzdSJ5Z96f3JVD",
+	"UynpanJncQbR+Hq6qoEHGWVp0+GoWEhoPl5LHI0np5lOofGXIm9FmLtoIuGPgklIJhf/MbHIYgYKT2dz",
+	"m+urcvhUghfVKKMCIMRev8xq5yvcWUPDGqH8Xm6YmP4nxBoXuH4WVzwvdF+uVfGNivE45qIlyx0zUGfE",
+	"z6LIkuk5ceATKoFkRapZnsKzeC5YDIarqchyLj0HaZ/CNSakyJHv4YZsMpZDEMZB8Nnu5DpSR5Mvz27E",
+	"M/iiJX2m6Y0ZfEFThhDiyWQ4Za5XUcb4999GGf3y/YvnUcIWYFCuBVV3Desx1Yz37V/+Mrlbx187cBcU",
+	"ceS6C0fqx9KRuaZ0CmkjJR7gXHuRsoWkTsp2gO57NJSYuFqCxHuVGjI4I7/NgeONPaeKUILrjfBPpkiB",
+	"tCZ4DIZSloxzxm8cYTlBAO/7ZJNQWrZ6ACYd4HDWTsDC1mWjfxUaBm2zk1047rEb6yJgLPhLXnIry3b0",
+	"nGqypKsIH8LtjudCASeVYCS45VsNbGm4ZNCdZZjh7hym7xYjug8sOIjZ90Vutsbyjo2T+aFIbkC/phpu",
+	"hFx9LLKMylVPLjE1Y0B9kxjXf305iTaoNprEbrJGhqFy4LrTQGvIV44aVfD44ZpQ8jVNgSdU/giQ9Fyv",
+	"FrfAmwUPWRfICskmu6gG34ncmI2AziG+TZnqTyuxf9Pe26iOmJsxQp3BXNEyAUMTK7IECYRKSflNE9sZ",
+	"Irh3vDzKy7rTrV3uxpWGrEn4bBX028TFbRKhk/KC1Xt4t57UMNZWHZeYlVJYRIChIEX0nPEbhQJaTuNb",
+	"IsJvErF5Ys370PmesLwDp8KbSYtE3Icg43Z8++biwfcjWKoUu+EA11YDadJJdoohieCwlxxzI0WRd9IS",
+	"uxKOukaggtOYCpECNTpZLhTzAt8mCx5CCxb+aH0zKziqPQqm33mUA6UtpEIra5Vkc0ZwPKekIP0omgEx",
+	"YBstRM1RQtDiBpCgrO0FH/MLIlmhNJlCpQFZ44uQRHBjMEEOmlOpWcxyyrXyM+LoXGiC6ycFT0Ep4naF",
+	"KLpSVhdaMgWbdLo3bnaXB+x4d91xsYfaE4iVW/HyATSh14Z7r4ugH0DlgivoyUq8dHndiUjXYAxfboe0",
+	"Rh4DwcRzGQSif7EdvE+S5W4zGaiB8HnLw9UAEIN3t0NZbuRAIEvOMmgna29vB/TySw5cwUAwwb49CMjg",
+	"3e0gDoQN2eiQM3bvbYfpI2idQgZ86AGrcoBBm1d/fTusnyDLU6qHnrF2rw+CM3y5EcpCSuDx6geaUh6D",
+	"6glb7F5vlLrD67KzkP++esnB1CTpa0m5moHsPq5FmM/5J/fm5qjrNOyXtraQcPKmHb2UUsieQs0PNCE4",
+	"Nyi9ISQAjtd9nT8ySBMLQ8O+ZaAUvdnjEt68fP2QjXthWUx/Ec/xJiOnzcXSmc5UnjJ9RqZUwTXNRMHN",
+	"t0aOs3/Fgi9A6rox+xtl3iD+QK2QCF/iOSq815JqsFZr/ITqMcxmEGuyRBMefu+BWVJFJMRCJpCckU+o",
+	"Ohvpr0hTMhPSP1c9RKYwEzIQKuc0IXQNGoYGrYTNZiCB6/L7wzvg7B51NNQEe9z4Rsts6yOE/GG3h3Cb",
+	"cWiIFWIrd6rhXMPvNQQ5pO6W0xXILSJ/g10sZfq61bhhfu7OIBxNfsS3mlhEkSc9N7pJcwx3t0S9qM5U",
+	"q21Y96EFKy7Xt2aKCcCMagbAGtqtH2MdsbewrIGqqWcVOWUJma688hiwotKz9qliW0x5q5wHHB9kXEhS",
+	"cKZVRFQRzwlVJAajel56ToOsTBQaVWG3BYYlzVhqIgkSkFb/PDA3GWDg7s59uuuDjH//wvnbgv/MdO28",
+	"pK8NncaxyDKRUOOgMXd/LqQmMyHKmA8GCk0MeW5cObjhFohW/tN5jUyJl9+++F9muK0ca5AXqB8r6jxF",
+	"ZWzYxrp62iPhj4KmRM2pBEXgC411NcMw7jfM39uMft7fu8YL+7LBJsa3hUuZZfTkUsh3cipLS7NnWWIJ",
+	"hmXR0MwVbWdMDWylj5DR5w40577lGm7zDZU7awcoD2HXrg6z4wdbV9tcL7wahn3msZgp4kHdlDbLV6Yr",
+	"/zxKw+7KWDr/cviUIQr3QL+j2bGn/UIgnrew5GOyma7Y0W8l1eWySdl28iYsCjSwfsr0DF9sDlRrU9vW",
+	"obJDRFuVsp9A1+14b+hqmAkvMDjuZLwbs15xDo06auLk7Zro2eIEyan1ja4bm9fZsB3BvxCFC+i0RRbY",
+	"wwfQdMb1Uo7aHbgxWLHvHmDY1cE63KrQOTiv0/HtZ6k+Fpqv9hj2DV01DVoGwAwOhdtpFws2JZxvyzl4",
+	"2+JQU7wVPfqcwoZVs6Oxbwc/+Jnx26GrSPHdzgvAmXYCbYdsgvcqQxVlT9v9MHuze7EJKrOovhIVLpIo",
+	"ujBBc6UibdXnXMKCwZKYW095zRdVXye1Tml8i/5OjLSfC6nTFaEzbeNe7MhMEWdWQF8v04oUMiXWZKCs",
+	"n7i08ZWR+dzEkzErP+f0BkgsijTh3xgXsgSanJGCzwqZGgMF0ZCmyopsOgAb7Ykz0PG8KepmRhcsFvy6",
+	"OazoYLYoC8r1mmq5c3j/HsvoDQyF0Q9SXh4739gS9l1t9/AwjSEhXLWLysZz1dfVvMlNWxjVDr2+pmZ6",
+	"Upa/mpi3oYzJRsx1Z03VjDsZlB96G/Clo1bt66kdEEi2+16oBt+2CG+P28+L29uKsRP8cuBtwFeuVLW3",
+	"L7WvPw7f2bmKcPhtC/F+VrWno7UfLfhZffzsrtVUs2xby9A1cPii0fytrPa5m6PiXL3Wu3uBZsioBkrT",
+	"UgNPb1+SF3zGZLYnt08gThnfc5AeJizWrNszvmC9w2vVdbkHzbFWGV1N94tZ5DTrdinnc8G7PVkldAEv",
+	"MhMVBTyximK1nupkJm4dk983Bmu6hQ3E0SSITQwHLdO1gg2P6qhUx4lgE/0id6CxD1joJ+P+hkkK1DmG",
+	"rOcIxU6xBOMWEryyvp7Vfk5ILDIgMymy0vccEQVcmyckxMBQbjYPBEz0jLwiNkhzgSKygRkF4SUCggKq",
+	"A0XNUaolN6CNIB0RSjjcUPMaQuWfXxGlWZqSOV2ADVJu8F5Pq705rGUYN6LjqLh5HR/1m9fxcTUwc8Gv",
+	"ykDm1uJGC2CIyt1rRECbMHSIvMD/LZZOQ7FjGPMy6idFiiEPPwoZZvllQmmXaLOZvuRjYatUm5zFt2jD",
+	"Nv4xRKAgDLdMaFbESM1JoKfZEc5IafkhCcwoZlaXUR4sK92sDhIh/Rd+LUdMPLwvH2pHRWlI6O5dd8Ta",
+	"M+xzkFkhfLmJBj6CUv1THOFLziSoXldvWwLQGsD2sSicohnutai0nnvax9uG10CfsBMtuj+97oaopgrG",
+	"2ep7C/SBI+7BwaOI+m5qR7vMHntvBtxxADUP9I588+pghrpEV/gyyWgCZAp6CcB9FAwycCuYkCI/oq/5",
+	"MCElh43m6IU5A3yiWhxp9IiDMbh+/6MU2aWbrx8DCDazCeWMmtnTirUebdgU76cZp97EmTH+M/AbPZ9c",
+	"vBy8H4guL81uA0+O5ZQ7jh9xhwrZecDQ5ao0lfpY27CpPCaSzvSa6hijmJym9rPI8hQ0JA36YzRBiK7/",
+	"q56stCOiscSfcKnV2bernNVc6wGKbejvLKxHvAp3Rfh2iOjtGcG77c2NaNsdCTZVwGfTlbZvEGh1AkO1",
+	"qAwDNsu47zyl3F52OfDE1jDwSzj5a29wJOVaAN+pBFL2QaXtyNG1ZMJasod510ZzlSkDOcg6RgQosBZB",
+	"XEsfCGKBTbGL0BFZZUNQCfhdGPanhaapKh2cMa7cOk4LXr547eFrsud0ZAZ9/PbNFSma8n8Q+uuexSfs",
+	"S92rTESTpq3oUI5mnf0EO7EBeh2slinb0ZAnae/0GsuTyroQYXZTFBIi4oaJMYhskordMJKBiW4UiGLM",
+	"BBpg4CgWJjBDvn/38RM5xynUuf35jFy9sd75FGaaiEJHRDFjdDS/I63TdInJ0lYXUYTDkgjehHcDYnKq",
+	"nfKmjN5Bv2U1J/hiF9xLg+wX+1GB2xgF0hnUQel31eShe2YwDHbY6wVI1VyQoJcIqJ1e0G0B+GnTl1gH",
+	"qH6gboaNhL9ayFN71E0DnvWT344a23fwEL3N6Lztm9Ip/Ke+IfvD3C2oo+uA0RKmhUyt3fT5y7+1bwrO",
+	"un07npT7c4D9ordftBdW3Jd/dFgZjY4u1X6Df/ftmh69X3kg67klJTISj1DE7G1bxHzpprVr3M8vu53C",
+	"Dm9G6r7ha3lboeXpRG1N0Y22Zr2PxrTxyqZXmTI4xzRT2gk8Me1nH7oP49NeRGMsVgHJlNYqUtmq7rbb",
+	"pjrPiGOYIZqS0gJLVnAA9eNuM3KFxiy3L2106GOjjh93H87WKuV397/0l9j95M2R29V10VCw+BD51u78",
+	"GiTV2gy7Dqqz4NpeT3hO8xxsTVNr17phC+Akoat6bTtjccDHTHjKi4hQHTyOKyf/Np9fZNm/ewOZs3+Y",
+	"nxD/NpXDhK720DECM9h3f/1rSYh70CD+jUN8/+IvF89f3k8VvcToZwbwXafdzbh5P7TZfU+9bnlgGu0P",
+	"wH6XVvtJttPyrgO9fxWrJraenqq1Hpx7lBLY98Pat3JxqzDNhGGCNdZ8qXKI2YzF9F//91//HxRJKHn1",
+	"/orkVFIijN3uGfAEv6Z5ah/7P8J4LPgZSBRWlJbFv/5fQklSSMo1EEHe/vwb+YcoJIcVvvlBxLegFVB9",
+	"VmZDXEz8GJNoUhqAJi/Onp89t6FTwGnOJheT78xX0SSnem72/Rw7U5xn9IbFz1KH0rlQenN1n4I8HsHT",
+	"lY1+dJk58AzlGKzkqEzXDa9waxtYSd1FNC3sxSNdXJOvKWRKSLoKrEsb0ojIYeQmrGs2eS+UflXo+S8I",
+	"qCE9e3qg9A8iWTlVXfuQltxsL759/p/KqgKWOfXEyqMJ4x0znOvPaVmA+cJunpnk2+cvey3eO3RR28UJ",
+	"61rv3Ubti8kbGwBIylC0u2jy8vnzXpNuza+w9bPu7rYV6sJflecrk49IRJQodsMRuQxSahFINBYbDX2Y",
+	"s/iPCWL55HccxiL8AiSbrc6/mtixO4TRuYDraPcTGKz71Tz9ycWZITlnoEHiyF8nDIFFevL846KMSKsf",
+	"XRTsyDov+n3jWJ8/NE73wdHTR5m5C7d1jGoNezA90LAzLaKy0E+hUGRmugGPopJHNrOp00eY7flCNsLz",
+	"cRztpQs4WD9TLBNHibJraeEFsatOfz5z5ekTSEHD5sG+Md/Xitk/TUaMc744/pyfOZ6SkOy/IFk77w+w",
+	"ELe20J8/PoLH19xqq1Agw8P3r9SIeFPQMQOaZEQCC5Ar68fF4XHAUs7R9BasV5cwfkZMHVLjYjVeVTOI",
+	"NOCqKhtZFMpG5CthfMKfP/xMlBa5IkshsQx8s/SzHfcOdyS1eUY0bEVDc9amxZwUGj/tj5AbHEl5AeWM",
+	"xSoQUupAfgS5cAhmQgH8vDjCN8pVVze9cfBCSysoz8pDVCSmUq4I5eTyE70xuBmnDDcJDacmE8WGHFzN",
+	"nr0VHJ79QjWWyeM2Z4mS756/tFn3mHNinBXzssfFhkwV4pgyt+RVrB7sotTwRZf7Xkea9cE6UcN39iao",
+	"P/hWaPKLSNiMQWLR9yFI5uXx58SF/ohlINYlsGKKj01N2hqtU8o2erAGfAOiOg+DBboI76/DtwMvsPqT",
+	"yPJ53bHdsdjzpEd8x2Zx/WAcN8xj1wmMN9RM6mRI4ySqMMfKkxJmNo2i0hDcQ5tqQoinO9SFh0PRo1tP",
+	"Du62L5v+Hc95z/j3f6tc+HejRaYTKTkkVvWKljb42knOUhQ3rl+MpTBDVEbpFnydDDcNOGsUtXk12CjI",
+	"vneCqf/wWPV0x58fFa812HAgLmuOvA97fYDTfrK8oBPla6sCGxWl6WSRzOsyX/DXVXLneUCrbvQGcglW",
+	"8WKczOiiSlZvQ0F1Rt6he4MuKDObT5ZzlgL5x7vPH95e/vP658ufXr3+5/Xrd29/vPrwy6tPV+/eXn94",
+	"9/nT5Uf0ZgDHV5rVnvB2Dz5fvXFb1gkpaxuwFTkPm8b2VHE7mrx8cQ9z/mTDhwZfqnvdpsYfGM9PmXze",
+	"I4AjAY0EdEAC2iFfbr14pFrkVgNvJJwQU40Fzfko9ByY9D2sqSaUr1zHanSNxxRTx25sqIAp72Mjfx1J",
+	"lWQNSW1BOL4dsi/dfMBVnD7R3JumegTN9ECaaFNSdr86Xj3jZ7sHm7cGpo668kN6K3DS744/6Y9CTlmS",
+	"AF9jvq8MQ1JeVqnEkR1ct1YZsk2HL8tPTo6oXrfXuhwRrdUthptWKfrlYXbzhQX1Otu9s+WQVALJJVsY",
+	"55uonLPLuSiTW/UcsjPyniplgXIvk6s36BELOjZa51VcOXAt/MJUuSaum0htjG9UYwJvs/e2jrDD7rOu",
+	"Ibeu104X7ntAt3F7C86RWnY5kWmdWtpIosYez7/6j1fJXT10ZY1gjE+YphJosiorsJtMgBpJlEnjBdei",
+	"8JXSm8JgSmQuMe5NN3taCfCodo0yxxFkDoueXckp2i1ePFIEf36Ue2Vk5KeE6z+B7o7oLveoPvIHyFMa",
+	"u8ghxODmiiRlKpmfhWy5UZjudI+8Lx4jjZ2M2DZeYH9SonYk2Usg9F0FGv1fvzkf6oylSFRRh5jWtdyd",
+	"MxIkKhuuECjt7tusUKYKVrOG943yHgjEKFNMmkogylUzWpEy95n8Wy5hxr5YZeuZCeHG9bj6B0ImIP/d",
+	"wXDDOM5wQXKv3AVNEJBnUWLaBVFF3HdamFhF/6gNwr0FMAXQyiwkt1NmFgSx0ZP3yfVdaGJXfxQgVxW/",
+	"Wkvz3mRQrdkXzeNt7P5BRi0rQ2yyjX1KP26Zzpz4TINshn9r4l7zoCZt3raBP9iYiAGNuxLm6z+r/ui+",
+	"BRYpJ9uiCVreTFnG6kBl9AvLEC7sjjvJGHd/NdTE//0eDGajoewkL5eaaa6rRW4jxKY+12tnZ6PE1tuw",
+	"4T08cRzfMmYfgX7rGG2GrNixEwzpIVezmimOKZvHF5XQuiui0QhnhVScs5Jeo6qVgmvJUV4xbQUOyGfl",
+	"DH9uaN8vWJEpmF9qG0VVPXKlweTn7oh7cF/dRymdQxdxNuhxrcW19WjWigt0y7a1XzawzT2KDQTVcv48",
+	"pX927tfJFwaKZoUupPXvB5xif0WvsfPH4IJA1WhdKwI1l/3ZII4tpYOGuV2PYfg//Vu/2faOqXquGGVT",
+	"/GFYXrW9OsIHCFxOrtqBua1w9Ks3vq6ry8AyFZlpbJvtYL7h1FRRKxUpPGCjGrXc0KQWX0JlUB2WKh81",
+	"EpmuUbCwvX2YJFbILxu2mimrvqu2mZWBN4gdY1UdIcICo49XBs6ILQDnayJTVxA5hMf0raISIqKwVDc1",
+	"+Y/uMpeQW/NR6ApGNTQwRYlCK5ZAsLMIrwREd0jsOJS8fP53o93iXW31WwQyZbHecj/b7r1HdMrZ3blv",
+	"omxoSjyK4uui+N+PP+lrh4FrrMcej7fyGAGVkn98fPfWEeXZVlb0Ff/Z4fQr48XMDFYAxzAx+7wRut/v",
+	"rEXtyCwTC6TjVPCbQAB38XON/kETZo9AdjTpmvWMPsFR6z22T7BZtW11Az5CPD5aqum07JLSrWN12FFg",
+	"aK6pk8rc1I8nzfSJ+yMT0Ki/tNiRmlyRb6gGFyLtBMml6YeaAl1AkygYlLd0t9SsUJBEvhVm8ArDNids",
+	"plEcZvGc4HXmPSBlJc2G283ajCKURRMpcv+6vQ9V2EsTZ/HtFhC6Zn/nyTOTFnNzrTZigyEc93YSTXCP",
+	"mszfv48WsIcvLX3Ugs0PbjAZI8/Hmyi4iT6b6p1bJL5NVeq8Xmq30ZX+KtCQau7rsi2ysXOYFh1n5DcM",
+	"J76RojBmk4SuotIMQmiqhP3NDlDWQknoCq0zCV0pf93EYgHSX1dmXb6v55pv5JraVuSOKMyzKsIX8VGh",
+	"oMynrtZ6RmyjYag1BUMQU6Zc0p/v6ayitUuSSdOQWrU7yO1t9yq8Px7RvWcOqNkjTletN92RXKtuS6u9",
+	"HO06Jy4EB1QWsKDq2x11Cx4TAd2PiPe4OjZV0PrBT8dRM3KR0+Yi9awQr6O2spGt4sz5V//+hsV4h+m2",
+	"wpJXfoRT11zrcFQLH03LI50ePd1kJ522VtkonTVGc3eVqKn0FrG2ahgjrT5NwaU0muwjudQLDbwYa92N",
+	"zK3O3Ix7mKbpihShPaUTmyualJpCjwxr1LROV9MaOdlTsAcPUqemNKU83mIb/sE94Pr7Z3mhISEKkJ9p",
+	"SFcmkwko2nOdj+qM/ALZFCSGkJvocp4QsYSkSkSimSicHJhTRFwyY1JpY0e21t+UyhtQmiQwdVGAOWWJ",
+	"r4Xhf40lJEwrF46IiyRTiZUt0fRsw/RNvfdyYt8K3zeKx1j86lcTujgzuWTcxBZmQuLPlBPBgRjvq/kL",
+	"3+AFrhG9tZld7S5jsd/IP2m8RQcTr9+B0TRzqgZeEzxrTwkx25C1RW9DxXoeEIkNZlCgdQqkyGuhc577",
+	"eHpr4T0m+GV7NaSAftzTT498fPKZ24GRfE4+BY041G6hhZZQIXe+7qblCzBuWC3KYb9RBKNBypu2iuox",
+	"adOagG8YJakGkgqaQOLdrUyRW8irak9ZUHpxLZS2quiY7Qr4eQRUefwInYqPdW4ia/fNFUYYmtaFJRT/",
+	"6jvJrukOHqhRYxjZVEMZho6cquHWLlssbWvc9CnsGDWnilBOaJo+wzAQWADXROWUc1+eYDkXaS21qATP",
+	"RuszblJSgzlccg93o+UgK/WHXJrvPl+9cfEsGpHRdiUz6Ql+2hlLgdAbyrizBNnYRzMkTqo0UNPrKinc",
+	"AfGbFq5Yl1V8F6jOvZ9OUF45dO+okb7vg74vv5j8m5J+mGYcJJUrYomQvS67U7Gu2TjntiZGe4Zgqxxh",
+	"3ivz4P0D2JK0zLKLXABYWPWZKcKFNg3FKk3+jLz2pTkM7ZfFOVzSP07IBcFALpCYp9fuYqoHodhhx3Se",
+	"kXQfODbCUkuv4M54DvGtKXzUUYt+Xb3wdBXpahNGXfr0dekKx70RikliFK1ac8Xyqe7hh4+DGA6fvV2u",
+	"+4HrKZdwjGR4+sF6JYG1Ut3W++n8a/m5b7heRaYV4j4ul3qw9FF4HGn16AF7Jb6ZG5Nptfu+3B3YMpLh",
+	"47/FR/p/EpEgB72rz0unRk+hOmATV2aIkVc8PK/QkD2g1F+DY5T6T7K7VWL8Kkj03vcMPLFlp4/AV86/",
+	"4j8HUQkMkzEIPoolg4GxpzFqKSNvOaaWUikoiG9H0EtGXvB4ecHpiD8jI/qzq0sdGVEfscb08jDqUlOQ",
+	"H05zzRJVa1eCX/rCXxVEgscQ+Zq2WLzWjLwrIq+RHb4zMI2M8EQiBj0SNJeGb1zJfrXfy72uxQiWYIxB",
+	"giN3rAUJGk5j+I5Bz320v9SVT9vVZQMZXNBpQwdpO0GdtMjWhDJ5RXqtPlRKbYlsqkwUkKvPTYRkN4zT",
+	"lJheGOu1rrDGX1XsykwnZjMF2r9e9v2oCgfHImf1+t8mwMlWtL+ulftmiijQUR2QfKPEuWt9b2MRke+X",
+	"PN+XJw/KkreGW+FWY7TVzognfPBJh2w3HFUQ2DgVIgXKJ/fS9mGz5fxGw6Wx/cHIpRvc84bc+4WLWSbS",
+	"Grb9BnIJthkD42RGF7bfnOGM7IZD4tmQWaDrCm75EV1QZu5zTDdJgfzj3ecPby//ef3z5U+vXv/z+vW7",
+	"tz9effjl1aerd2+vP7z7/OnyI3I14PhKsjOe2sF9EixrtFu9uIc5f7IVU2sI7/pq0epOVlA25Apae6iO",
+	"5FAmOnSLnbz0jz/dyEm/BeONcPpxkx6725MQhWpJ3cnpyqX8GvsE3jM4rHvbCLV5ylwuYdgi1WUpWpkU",
+	"Lw9uEomZVjWh94xc/lHQ1I4SpOHHJvHGJBYnAtvtJGzBEpuRk668TmCKBdSFaFvU1SYFuUGx++qcSqiG",
+	"z9brB0jIKOMJJu+Tyy801v5dsySaJCavWYRr/0a56gVn5JX51yQnCT0H6dMyGdxXCqcHaqfA/xg41+Gt",
+	"vm7VDxzk6qAYOeYJO7s9YwsItXtCpP/1/Kv71NeZ7anT4+vj8liVix59xiOJHj2yNbjxGmWaHmL8SG4n",
+	"oVq4YxjvxROt9r6L4jpEaIwU93hF4vFe/ZNXAulE5s1ir2sb3FoBxLX+nQss/Vy1uF23BjS36LTKPPq1",
+	"wp67UxrfWv33/buPn0ithXFE5iDBmh0I5VYpB75gUvAMuN5lZba1E56gdW+9m+9IoqdZzIOqIc10z62z",
+	"s3sGx5V7/ik7iG0H+hB6+81g8O3rG+7e++t0P17Nj4buLQESJTIQvNUqFV6hzYRv7tGOzq2fzbNPtjat",
+	"Wf5ooz1xXdQGHAQ0YL7Y4swyoQlzra23GD+oII6LxjHkGkOqUFotZGorT8mMprhyMoWZkNiWEKPKFF1A",
+	"cmEo0SwF7JBC2ULWqViCjKnp1Js4XMHb286USJHnrnCVljS+xRErUiOqiOd4vRc6u1aikLEdfTaNU5aE",
+	"zerPyCu7FTFF/9ichgWsA9BxMXrJYgh8RxKcEG3kZ0pePv/7Ts/RybOFo0sC+1f1jyaFrMsShWTDl7+E",
+	"aSFTU/zz2+cv/7YpU1iI7az3IVn02EykvaukvheN0c5rS3LvjX3ST4xR44x/P/6MGPmTslhvLVCDONJ0",
+	"N7QJRudfLVb1c9YZhoj/e2RWTEdBo49upPxj++jaCPGIXeJGqvxTCE2brdsOIztV4zYKT2Pu0yjEPJgQ",
+	"09aNbgsX3e1xHfnhqEQ+tBI5ssiRRR6GRX7Ok2F63np+Xwc7+PvwlT+nObwH31rfwE79bYIt3EhbX2cz",
+	"dSfGaN55HHb4Wi5HL5dU+MT51+CvDTtMtxYT1ixeB4j8NgduO0is0O5tyNG8vNZkQos0aDBh805MRvcS",
+	"Aov7hvK5YRMKeUbw+ZHJXrXDeAhw/ihArip47AFOos3GM2V+9mhjekrxaobSaY2IZ1JkA53jeNEJRdPu",
+	"cTHvyzeeVBqVb2Pul/+A+VTroIwO+5Psr29OB0zPN3dgYQxL2DC5a5vqkljPv/qPAwQGdKkv54LYIVxH",
+	"6hJEFCfsaI01VDZvfQ9TSRiP7L4vN3L0Co184IheoYANeJzbn/bx60xo6N+Vzr1YwqJ2BsE0UPp7N/tI",
+	"8A8klbgDOAnhpAWWUTo5UekkEy1sCcUUasL7ksL0mXQPHIBdLYSXUjoGmjQwnV9HjjOKGCMxB8T8G9Pz",
+	"RNIloQQJzCZ+9RA3WjrxtykPZRHGevUZlCpw+siWbsxBVjOTX4UtSmlKO0qbdGez4XIJCyYKZd7dVdZ3",
+	"ZAcnbhbB0xjzakeW9OsQPtQgRyjQOoUMursuPwZvPN0SdcEujAL4SVepy+nKHBOREAuZ2EJpFu1N4bXt",
+	"lZg69vh9JERx+PupWvgDF0CrABnp8TQda0h9hHp6JBlNoBcpbr+9zr9Wf/TNtgioN8Dnx2VnD1c/qsEj",
+	"CR8z/6LCtZKay8t1uiIZU5rethZ5ubv77wEAsETy6eNiAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"nlw-journey/internal/token"
+)
+
+// consumeToken verifies a signed token and records it as used, so the same link can't be replayed.
+// On failure, it returns the error that should be sent back to the client.
+func (api API) consumeToken(ctx context.Context, rawToken string, purpose string) (token.Claims, *spec.Error) {
+	claims, apiErr := api.verifyToken(rawToken, purpose)
+	if apiErr != nil {
+		return claims, apiErr
+	}
+
+	affected, err := api.repository.UseToken(ctx, usedTokenParams(claims))
+	if err != nil {
+		api.logger.Error("failed to use token", zap.Error(err), zap.String("tokenID", claims.ID.String()))
+		return claims, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	if affected == 0 {
+		return claims, &spec.Error{Message: "Esse link já foi utilizado."}
+	}
+
+	return claims, nil
+}
+
+// verifyUnusedToken verifies a signed token that hasn't been used yet, without recording it as used. The returned params
+// must be recorded along with the work the link confirms, so a failure while doing it doesn't burn the link.
+func (api API) verifyUnusedToken(ctx context.Context, rawToken string, purpose string) (token.Claims, *pgstore.UseTokenParams, *spec.Error) {
+	claims, apiErr := api.verifyToken(rawToken, purpose)
+	if apiErr != nil {
+		return claims, nil, apiErr
+	}
+
+	used, err := api.repository.IsTokenUsed(ctx, claims.ID)
+	if err != nil {
+		api.logger.Error("failed to check if token was used", zap.Error(err), zap.String("tokenID", claims.ID.String()))
+		return claims, nil, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	if used {
+		return claims, nil, &spec.Error{Message: "Esse link já foi utilizado."}
+	}
+
+	params := usedTokenParams(claims)
+	return claims, &params, nil
+}
+
+func (api API) verifyToken(rawToken string, purpose string) (token.Claims, *spec.Error) {
+	claims, err := api.signer.Verify(rawToken, purpose)
+	if err != nil {
+		if errors.Is(err, token.ErrExpired) {
+			return claims, &spec.Error{Message: "Esse link expirou."}
+		}
+		return claims, &spec.Error{Message: "Link inválido."}
+	}
+
+	return claims, nil
+}
+
+func usedTokenParams(claims token.Claims) pgstore.UseTokenParams {
+	return pgstore.UseTokenParams{
+		ID: claims.ID,
+		ExpiresAt: pgtype.Timestamp{
+			Time:  claims.ExpirationTime(),
+			Valid: true,
+		},
+	}
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"strings"
+	"time"
+)
+
+// tripBundleSchemaVersion is the version of the bundle format written by the export. It must be bumped whenever the
+// format changes in a way older imports can't read.
+const tripBundleSchemaVersion = 1
+
+// GetTripsTripIDExport Export the trip as a JSON bundle.
+// (GET /trips/{tripId}/export)
+func (api API) GetTripsTripIDExport(_ http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.GetTripsTripIDExportJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeTrip(r.Context(), tripID, ownerAccess)
+	if apiErr != nil {
+		return spec.GetTripsTripIDExportJSON400Response(*apiErr).Status(status)
+	}
+
+	participants, err := api.repository.GetParticipants(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's participants", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDExportJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	activities, err := api.repository.GetTripActivities(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's activities", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDExportJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	links, err := api.repository.GetTripLinks(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's links", zap.Error(err), zap.String("tripID", _tripID))
+		return spec.GetTripsTripIDExportJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.GetTripsTripIDExportJSON200Response(buildTripBundle(trip, participants, activities, links, time.Now()))
+}
+
+func buildTripBundle(trip pgstore.Trip, participants []pgstore.Participant, activities []pgstore.Activity, links []pgstore.Link, now time.Time) spec.TripBundle {
+	bundle := spec.TripBundle{
+		SchemaVersion: tripBundleSchemaVersion,
+		ExportedAt:    now,
+		Trip: spec.TripBundleTrip{
+			Destination:  trip.Destination,
+			OwnerEmail:   types.Email(trip.OwnerEmail),
+			OwnerName:    trip.OwnerName,
+			StartsAt:     trip.StartsAt.Time,
+			EndsAt:       trip.EndsAt.Time,
+			TimeZone:     trip.TimeZone,
+			Status:       string(trip.Status),
+			BaseCurrency: &trip.BaseCurrency,
+		},
+		Participants: make([]spec.TripBundleParticipant, len(participants)),
+		Activities:   make([]spec.TripBundleActivity, len(activities)),
+		Links:        make([]spec.TripBundleLink, len(links)),
+	}
+
+	for i, participant := range participants {
+		bundle.Participants[i] = spec.TripBundleParticipant{
+			Email:       types.Email(participant.Email),
+			Name:        textToPointer(participant.Name),
+			Phone:       textToPointer(participant.Phone),
+			Status:      string(participant.Status),
+			InvitedAt:   participant.InvitedAt.Time,
+			ConfirmedAt: timestampToPointer(participant.ConfirmedAt),
+			DeclinedAt:  timestampToPointer(participant.DeclinedAt),
+			MaybeAt:     timestampToPointer(participant.MaybeAt),
+		}
+	}
+
+	for i, activity := range activities {
+		bundle.Activities[i] = spec.TripBundleActivity{
+			Title:    activity.Title,
+			OccursAt: activity.OccursAt.Time,
+		}
+	}
+
+	for i, link := range links {
+		bundle.Links[i] = spec.TripBundleLink{
+			Title: link.Title,
+			URL:   link.Url,
+		}
+	}
+
+	return bundle
+}
+
+// PostTripsImport Import a trip from a JSON bundle.
+// (POST /trips/import)
+func (api API) PostTripsImport(_ http.ResponseWriter, r *http.Request) *spec.Response {
+	identity, ok := IdentityFromContext(r.Context())
+	if !ok {
+		return spec.PostTripsImportJSON401Response(spec.Error{Message: "É necessário estar autenticado."})
+	}
+
+	var body spec.PostTripsImportJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsImportJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	// the version is checked before anything else, since bundles of other versions may not follow this schema at all
+	if body.SchemaVersion != tripBundleSchemaVersion {
+		return spec.PostTripsImportJSON400Response(spec.Error{
+			Message: "Input inválido.",
+			Errors: []spec.FieldError{{
+				Field:   "schema_version",
+				Message: fmt.Sprintf("Versão %d não suportada. Apenas a versão %d pode ser importada.", body.SchemaVersion, tripBundleSchemaVersion),
+			}},
+		})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsImportJSON400Response(invalidInput(err))
+	}
+
+	bundle := spec.TripBundle(body)
+
+	urls, conflicts := tripBundleConflicts(bundle, identity.Email)
+	if len(conflicts) > 0 {
+		return spec.PostTripsImportJSON409Response(spec.Error{
+			Message: "A viagem não pode ser importada como está.",
+			Errors:  conflicts,
+		})
+	}
+
+	// links are saved the same way links added to the trip are
+	for i := range bundle.Links {
+		bundle.Links[i].URL = urls[i]
+	}
+
+	tripID, err := api.repository.ImportTrip(r.Context(), api.pool, bundle)
+	if err != nil {
+		api.logger.Error("failed to import trip", zap.Error(err), zap.String("email", identity.Email))
+		return spec.PostTripsImportJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostTripsImportJSON201Response(spec.ImportTripResponse{TripID: tripID.String()})
+}
+
+// tripBundleConflicts lists what keeps a valid bundle from being imported as it is: a trip owned by someone else,
+// participants or links listed more than once and activities outside the trip. Links are compared by their normalized
+// url, which is returned for each of the bundle's links.
+func tripBundleConflicts(bundle spec.TripBundle, email string) ([]string, []spec.FieldError) {
+	var conflicts []spec.FieldError
+
+	if !strings.EqualFold(string(bundle.Trip.OwnerEmail), email) {
+		conflicts = append(conflicts, spec.FieldError{
+			Field:   "trip.owner_email",
+			Message: "A viagem pertence a outra pessoa. Só é possível importar as suas próprias viagens.",
+		})
+	}
+
+	seen := make(map[string]int, len(bundle.Participants))
+	for i, participant := range bundle.Participants {
+		key := strings.ToLower(string(participant.Email))
+		if first, ok := seen[key]; ok {
+			conflicts = append(conflicts, spec.FieldError{
+				Field:   fmt.Sprintf("participants[%d].email", i),
+				Message: fmt.Sprintf("Participante repetido, já listado em participants[%d].", first),
+			})
+			continue
+		}
+		seen[key] = i
+	}
+
+	trip := pgstore.Trip{
+		StartsAt: pgtype.Timestamptz{Time: bundle.Trip.StartsAt, Valid: true},
+		EndsAt:   pgtype.Timestamptz{Time: bundle.Trip.EndsAt, Valid: true},
+		TimeZone: bundle.Trip.TimeZone,
+	}
+	for i, activity := range bundle.Activities {
+		if apiErr := checkActivityDate(trip, activity.OccursAt); apiErr != nil {
+			conflicts = append(conflicts, spec.FieldError{
+				Field:   fmt.Sprintf("activities[%d].occurs_at", i),
+				Message: apiErr.Errors[0].Message,
+			})
+		}
+	}
+
+	urls := make([]string, len(bundle.Links))
+	for i, link := range bundle.Links {
+		urls[i] = link.URL
+	}
+	normalized, fields := normalizeLinkURLs("links", urls)
+	conflicts = append(conflicts, fields...)
+
+	return normalized, conflicts
+}
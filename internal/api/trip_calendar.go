@@ -0,0 +1,128 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/ical"
+	"nlw-journey/internal/pgstore"
+	"strings"
+	"time"
+)
+
+const (
+	calendarProductID = "-//plann.er//nlw-journey//PT"
+
+	// activities only have a start time, so calendars show them as lasting this long
+	calendarActivityDuration = time.Hour
+)
+
+// GetTripsTripIDCalendarIcs Export the trip itinerary as an iCalendar file.
+// (GET /trips/{tripId}/calendar.ics)
+func (api API) GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request, _tripID string) *spec.Response {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return spec.GetTripsTripIDCalendarIcsJSON400Response(spec.Error{Message: "ID de viagem inválido."})
+	}
+
+	trip, status, apiErr := api.authorizeTrip(r.Context(), tripID, memberAccess)
+	if apiErr != nil {
+		return spec.GetTripsTripIDCalendarIcsJSON400Response(*apiErr).Status(status)
+	}
+
+	calendar, apiErr := api.tripCalendar(r.Context(), trip)
+	if apiErr != nil {
+		return spec.GetTripsTripIDCalendarIcsJSON400Response(*apiErr)
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="trip.ics"`)
+	w.WriteHeader(http.StatusOK)
+
+	if err := calendar.Encode(w, time.Now()); err != nil {
+		api.logger.Error("failed to write trip calendar", zap.Error(err), zap.String("tripID", _tripID))
+	}
+
+	return nil
+}
+
+// tripCalendar loads the trip's activities and links and builds its calendar.
+func (api API) tripCalendar(ctx context.Context, trip pgstore.Trip) (ical.Calendar, *spec.Error) {
+	activities, err := api.repository.GetTripActivities(ctx, trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip's activities", zap.Error(err), zap.String("tripID", trip.ID.String()))
+		return ical.Calendar{}, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	links, err := api.repository.GetTripLinks(ctx, trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip's links", zap.Error(err), zap.String("tripID", trip.ID.String()))
+		return ical.Calendar{}, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	return buildTripCalendar(trip, activities, links), nil
+}
+
+// buildTripCalendar creates a calendar holding the events of a single trip.
+func buildTripCalendar(trip pgstore.Trip, activities []pgstore.Activity, links []pgstore.Link) ical.Calendar {
+	return ical.Calendar{
+		ProductID: calendarProductID,
+		Name:      fmt.Sprintf("Viagem para %s", trip.Destination),
+		TimeZone:  trip.TimeZone,
+		Events:    tripEvents(trip, activities, links, time.Now()),
+	}
+}
+
+// tripEvents creates an all-day event covering the whole trip and an event per activity. Event UIDs are derived from
+// the trip and activity IDs, so they stay the same across exports.
+func tripEvents(trip pgstore.Trip, activities []pgstore.Activity, links []pgstore.Link, now time.Time) []ical.Event {
+	location := tripLocation(trip)
+	status := calendarStatus(currentTripStatus(trip, now))
+
+	var description strings.Builder
+	fmt.Fprintf(&description, "Viagem organizada por %s.", trip.OwnerName)
+	if len(links) > 0 {
+		description.WriteString("\n\nLinks importantes:")
+		for _, link := range links {
+			fmt.Fprintf(&description, "\n%s: %s", link.Title, link.Url)
+		}
+	}
+
+	events := make([]ical.Event, 0, len(activities)+1)
+	events = append(events, ical.Event{
+		UID:         fmt.Sprintf("trip-%s@plann.er", trip.ID),
+		Summary:     fmt.Sprintf("Viagem para %s", trip.Destination),
+		Description: description.String(),
+		Status:      status,
+		Start:       truncateToDay(trip.StartsAt.Time.In(location)),
+		End:         truncateToDay(trip.EndsAt.Time.In(location)).AddDate(0, 0, 1),
+		AllDay:      true,
+	})
+
+	for _, activity := range activities {
+		events = append(events, ical.Event{
+			UID:         fmt.Sprintf("activity-%s@plann.er", activity.ID),
+			Summary:     activity.Title,
+			Description: fmt.Sprintf("Atividade da viagem para %s.", trip.Destination),
+			Status:      status,
+			Start:       activity.OccursAt.Time,
+			End:         activity.OccursAt.Time.Add(calendarActivityDuration),
+		})
+	}
+
+	return events
+}
+
+func calendarStatus(status pgstore.TripStatus) string {
+	switch status {
+	case pgstore.TripStatusDraft:
+		return ical.StatusTentative
+	case pgstore.TripStatusCancelled:
+		return ical.StatusCancelled
+	default:
+		return ical.StatusConfirmed
+	}
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"time"
+)
+
+// allowedTripTransitions lists the statuses each trip status may move to. Cancelled and completed trips are final.
+var allowedTripTransitions = map[pgstore.TripStatus][]pgstore.TripStatus{
+	pgstore.TripStatusDraft:     {pgstore.TripStatusConfirmed, pgstore.TripStatusCancelled},
+	pgstore.TripStatusConfirmed: {pgstore.TripStatusCancelled, pgstore.TripStatusCompleted},
+}
+
+func canTransitionTrip(from, to pgstore.TripStatus) bool {
+	for _, allowed := range allowedTripTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// currentTripStatus returns the trip's status, considering confirmed trips that already ended as completed even
+// before the background job gets to update them.
+func currentTripStatus(trip pgstore.Trip, now time.Time) pgstore.TripStatus {
+	if trip.Status == pgstore.TripStatusConfirmed && trip.EndsAt.Time.Before(now) {
+		return pgstore.TripStatusCompleted
+	}
+	return trip.Status
+}
+
+// ensureTripIsEditable refuses changes to trips that were cancelled or have already happened.
+func ensureTripIsEditable(trip pgstore.Trip) *spec.Error {
+	switch currentTripStatus(trip, time.Now()) {
+	case pgstore.TripStatusCancelled:
+		return &spec.Error{Message: "Essa viagem foi cancelada e não pode mais ser alterada."}
+	case pgstore.TripStatusCompleted:
+		return &spec.Error{Message: "Essa viagem já aconteceu e não pode mais ser alterada."}
+	default:
+		return nil
+	}
+}
+
+// specTripStatus converts a trip status to its API representation. Unknown statuses become spec.UnknownTripStatus.
+func specTripStatus(status pgstore.TripStatus) spec.TripStatus {
+	var specStatus spec.TripStatus
+	_ = specStatus.FromValue(string(status))
+	return specStatus
+}
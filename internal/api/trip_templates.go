@@ -0,0 +1,308 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"strings"
+	"time"
+)
+
+// GetTemplates List the trip templates of the authenticated user.
+// (GET /templates)
+func (api API) GetTemplates(_ http.ResponseWriter, r *http.Request) *spec.Response {
+	identity, ok := IdentityFromContext(r.Context())
+	if !ok {
+		return spec.GetTemplatesJSON401Response(spec.Error{Message: "É necessário estar autenticado."})
+	}
+
+	templates, err := api.repository.ListTripTemplates(r.Context(), identity.Email)
+	if err != nil {
+		api.logger.Error("failed to list trip templates", zap.Error(err), zap.String("email", identity.Email))
+		return spec.GetTemplatesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	mappedTemplates := make([]spec.TripTemplateSummary, len(templates))
+	for i, template := range templates {
+		mappedTemplates[i] = spec.TripTemplateSummary{
+			ID:        template.ID.String(),
+			Name:      template.Name,
+			UpdatedAt: template.UpdatedAt.Time,
+		}
+	}
+
+	return spec.GetTemplatesJSON200Response(spec.ListTripTemplatesResponse{Templates: mappedTemplates})
+}
+
+// PostTemplates Create a trip template.
+// (POST /templates)
+func (api API) PostTemplates(_ http.ResponseWriter, r *http.Request) *spec.Response {
+	identity, ok := IdentityFromContext(r.Context())
+	if !ok {
+		return spec.PostTemplatesJSON401Response(spec.Error{Message: "É necessário estar autenticado."})
+	}
+
+	var body spec.PostTemplatesJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTemplatesJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTemplatesJSON400Response(invalidInput(err))
+	}
+
+	if fields := normalizeTemplateLinks(body.Links); len(fields) > 0 {
+		return spec.PostTemplatesJSON400Response(spec.Error{Message: "Input inválido.", Errors: fields})
+	}
+
+	templateID, err := api.repository.CreateTripTemplate(r.Context(), api.pool, identity.Email, spec.TripTemplateInput(body))
+	if err != nil {
+		api.logger.Error("failed to create trip template", zap.Error(err), zap.String("email", identity.Email))
+		return spec.PostTemplatesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostTemplatesJSON201Response(spec.CreateTripTemplateResponse{TemplateID: templateID.String()})
+}
+
+// GetTemplatesTemplateID Get a trip template.
+// (GET /templates/{templateId})
+func (api API) GetTemplatesTemplateID(_ http.ResponseWriter, r *http.Request, _templateID string) *spec.Response {
+	templateID, err := uuid.Parse(_templateID)
+	if err != nil {
+		return spec.GetTemplatesTemplateIDJSON400Response(spec.Error{Message: "ID de modelo inválido."})
+	}
+
+	template, status, apiErr := api.authorizeTemplate(r.Context(), templateID)
+	if apiErr != nil {
+		return spec.GetTemplatesTemplateIDJSON400Response(*apiErr).Status(status)
+	}
+
+	activities, links, apiErr := api.templateItems(r.Context(), templateID)
+	if apiErr != nil {
+		return spec.GetTemplatesTemplateIDJSON400Response(*apiErr)
+	}
+
+	mappedActivities := make([]spec.TripTemplateActivity, len(activities))
+	for i, activity := range activities {
+		mappedActivities[i] = spec.TripTemplateActivity{
+			Title: activity.Title,
+			Day:   int(activity.Day),
+			Time:  formatTemplateTime(activity.Time),
+		}
+	}
+
+	mappedLinks := make([]spec.TripTemplateLink, len(links))
+	for i, link := range links {
+		mappedLinks[i] = spec.TripTemplateLink{
+			Title: link.Title,
+			URL:   link.Url,
+		}
+	}
+
+	return spec.GetTemplatesTemplateIDJSON200Response(spec.TripTemplate{
+		ID:         template.ID.String(),
+		Name:       template.Name,
+		Activities: mappedActivities,
+		Links:      mappedLinks,
+		UpdatedAt:  template.UpdatedAt.Time,
+	})
+}
+
+// PutTemplatesTemplateID Replace a trip template.
+// (PUT /templates/{templateId})
+func (api API) PutTemplatesTemplateID(_ http.ResponseWriter, r *http.Request, _templateID string) *spec.Response {
+	templateID, err := uuid.Parse(_templateID)
+	if err != nil {
+		return spec.PutTemplatesTemplateIDJSON400Response(spec.Error{Message: "ID de modelo inválido."})
+	}
+
+	var body spec.PutTemplatesTemplateIDJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTemplatesTemplateIDJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTemplatesTemplateIDJSON400Response(invalidInput(err))
+	}
+
+	if fields := normalizeTemplateLinks(body.Links); len(fields) > 0 {
+		return spec.PutTemplatesTemplateIDJSON400Response(spec.Error{Message: "Input inválido.", Errors: fields})
+	}
+
+	if _, status, apiErr := api.authorizeTemplate(r.Context(), templateID); apiErr != nil {
+		return spec.PutTemplatesTemplateIDJSON400Response(*apiErr).Status(status)
+	}
+
+	if err := api.repository.ReplaceTripTemplate(r.Context(), api.pool, templateID, spec.TripTemplateInput(body)); err != nil {
+		api.logger.Error("failed to replace trip template", zap.Error(err), zap.String("templateID", _templateID))
+		return spec.PutTemplatesTemplateIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PutTemplatesTemplateIDJSON204Response(struct{}{})
+}
+
+// DeleteTemplatesTemplateID Delete a trip template.
+// (DELETE /templates/{templateId})
+func (api API) DeleteTemplatesTemplateID(_ http.ResponseWriter, r *http.Request, _templateID string) *spec.Response {
+	templateID, err := uuid.Parse(_templateID)
+	if err != nil {
+		return spec.DeleteTemplatesTemplateIDJSON400Response(spec.Error{Message: "ID de modelo inválido."})
+	}
+
+	if _, status, apiErr := api.authorizeTemplate(r.Context(), templateID); apiErr != nil {
+		return spec.DeleteTemplatesTemplateIDJSON400Response(*apiErr).Status(status)
+	}
+
+	// activities and links are removed by the foreign keys' ON DELETE CASCADE
+	if _, err := api.repository.DeleteTripTemplate(r.Context(), templateID); err != nil {
+		api.logger.Error("failed to delete trip template", zap.Error(err), zap.String("templateID", _templateID))
+		return spec.DeleteTemplatesTemplateIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.DeleteTemplatesTemplateIDJSON204Response(struct{}{})
+}
+
+// authorizeTemplate loads the template and checks that it belongs to the authenticated identity. Like authorizeTrip,
+// it returns the status code and the error that should be sent back to the client on failure.
+func (api API) authorizeTemplate(ctx context.Context, templateID uuid.UUID) (pgstore.TripTemplate, int, *spec.Error) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return pgstore.TripTemplate{}, http.StatusUnauthorized, &spec.Error{Message: "É necessário estar autenticado."}
+	}
+
+	template, err := api.repository.GetTripTemplate(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return template, http.StatusBadRequest, &spec.Error{Message: "Modelo não encontrado."}
+		}
+
+		api.logger.Error("failed to get trip template", zap.Error(err), zap.String("templateID", templateID.String()))
+		return template, http.StatusBadRequest, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	if !strings.EqualFold(template.OwnerEmail, identity.Email) {
+		return template, http.StatusForbidden, &spec.Error{Message: "Apenas o dono do modelo pode fazer isso."}
+	}
+
+	return template, http.StatusOK, nil
+}
+
+func (api API) templateItems(ctx context.Context, templateID uuid.UUID) ([]pgstore.TripTemplateActivity, []pgstore.TripTemplateLink, *spec.Error) {
+	activities, err := api.repository.GetTripTemplateActivities(ctx, templateID)
+	if err != nil {
+		api.logger.Error("failed to get trip template's activities", zap.Error(err), zap.String("templateID", templateID.String()))
+		return nil, nil, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	links, err := api.repository.GetTripTemplateLinks(ctx, templateID)
+	if err != nil {
+		api.logger.Error("failed to get trip template's links", zap.Error(err), zap.String("templateID", templateID.String()))
+		return nil, nil, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	return activities, links, nil
+}
+
+// materializeTemplate turns the template's activities into activities of the trip being created. Day 1 is the day the
+// trip starts, in its time zone, and every activity must land within the trip.
+func (api API) materializeTemplate(ctx context.Context, body spec.PostTripsJSONBody) ([]pgstore.CreateActivityParams, []pgstore.CreateTripLinkParams, int, *spec.Error) {
+	templateID, err := uuid.Parse(*body.TemplateID)
+	if err != nil {
+		return nil, nil, http.StatusBadRequest, &spec.Error{Message: "ID de modelo inválido."}
+	}
+
+	if _, status, apiErr := api.authorizeTemplate(ctx, templateID); apiErr != nil {
+		return nil, nil, status, apiErr
+	}
+
+	templateActivities, templateLinks, apiErr := api.templateItems(ctx, templateID)
+	if apiErr != nil {
+		return nil, nil, http.StatusBadRequest, apiErr
+	}
+
+	trip := pgstore.Trip{
+		StartsAt: pgtype.Timestamptz{Time: body.StartsAt, Valid: true},
+		EndsAt:   pgtype.Timestamptz{Time: body.EndsAt, Valid: true},
+		TimeZone: pgstore.DefaultTimeZone,
+	}
+	if body.TimeZone != nil {
+		trip.TimeZone = *body.TimeZone
+	}
+
+	firstDay, _ := tripWindow(trip)
+
+	var fields []spec.FieldError
+	activities := make([]pgstore.CreateActivityParams, len(templateActivities))
+	for i, activity := range templateActivities {
+		// the wall clock time is kept even on days when the clocks change
+		clock := time.Duration(activity.Time.Microseconds) * time.Microsecond
+		occursAt := time.Date(
+			firstDay.Year(), firstDay.Month(), firstDay.Day()+int(activity.Day)-1,
+			int(clock/time.Hour), int(clock%time.Hour/time.Minute), 0, 0,
+			firstDay.Location(),
+		)
+
+		if !isInsideTripWindow(trip, occursAt) {
+			fields = append(fields, spec.FieldError{
+				Field:   "template_id",
+				Message: fmt.Sprintf("A atividade \"%s\" do modelo acontece no dia %d, depois do fim da viagem.", activity.Title, activity.Day),
+			})
+			continue
+		}
+
+		activities[i] = pgstore.CreateActivityParams{
+			Title:    activity.Title,
+			OccursAt: pgtype.Timestamptz{Time: occursAt, Valid: true},
+		}
+	}
+
+	if len(fields) > 0 {
+		return nil, nil, http.StatusBadRequest, &spec.Error{Message: "Input inválido.", Errors: fields}
+	}
+
+	// templates saved before links were normalized may still list the same page twice, which the trip doesn't allow
+	links := make([]pgstore.CreateTripLinkParams, 0, len(templateLinks))
+	seen := make(map[string]bool, len(templateLinks))
+	for _, link := range templateLinks {
+		url, err := NormalizeLinkURL(link.Url)
+		if err != nil || seen[url] {
+			continue
+		}
+		seen[url] = true
+
+		links = append(links, pgstore.CreateTripLinkParams{
+			Title: link.Title,
+			Url:   url,
+		})
+	}
+
+	return activities, links, http.StatusOK, nil
+}
+
+// normalizeTemplateLinks normalizes the template's links in place, so trips created from it get the same urls as if
+// the links were added one by one.
+func normalizeTemplateLinks(links []spec.TripTemplateLink) []spec.FieldError {
+	urls := make([]string, len(links))
+	for i, link := range links {
+		urls[i] = link.URL
+	}
+
+	normalized, fields := normalizeLinkURLs("links", urls)
+	for i := range links {
+		links[i].URL = normalized[i]
+	}
+
+	return fields
+}
+
+func formatTemplateTime(clock pgtype.Time) string {
+	return time.Time{}.Add(time.Duration(clock.Microseconds) * time.Microsecond).Format("15:04")
+}
@@ -2,16 +2,17 @@ package api
 
 import (
 	"encoding/json"
-	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
 	"net/http"
 	"nlw-journey/internal/api/spec"
 	"nlw-journey/internal/pgstore"
+	"time"
 )
 
-func (api API) PutTripsTripID(_ http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+func (api API) PutTripsTripID(_ http.ResponseWriter, r *http.Request, tripID string, params spec.PutTripsTripIDParams) *spec.Response {
 	var body spec.PutTripsTripIDJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		return spec.PutTripsTripIDJSON400Response(spec.Error{
@@ -20,10 +21,7 @@ func (api API) PutTripsTripID(_ http.ResponseWriter, r *http.Request, tripID str
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		errors.As(err, &err)
-		return spec.PostTripsJSON400Response(spec.Error{
-			Message: "Input inválido: " + err.Error(),
-		})
+		return spec.PutTripsTripIDJSON400Response(invalidInput(err))
 	}
 
 	var parsedTripID, err = uuid.Parse(tripID)
@@ -33,19 +31,86 @@ func (api API) PutTripsTripID(_ http.ResponseWriter, r *http.Request, tripID str
 		})
 	}
 
-	if err := api.repository.UpdateTrip(r.Context(), pgstore.UpdateTripParams{
-		Destination: body.Destination,
-		EndsAt: pgtype.Timestamp{
-			Time:  body.EndsAt,
-			Valid: true,
-		},
-		StartsAt: pgtype.Timestamp{
-			Time:  body.EndsAt,
-			Valid: true,
-		},
-		ID: parsedTripID,
-	}); err != nil {
-		api.logger.Error("failed to update trip", zap.String("tripID", tripID), zap.Any("body", body))
+	trip, status, apiErr := api.authorizeTripChange(r.Context(), parsedTripID, ownerAccess)
+	if apiErr != nil {
+		return spec.PutTripsTripIDJSON400Response(*apiErr).Status(status)
+	}
+
+	activities, err := api.repository.GetTripActivities(r.Context(), parsedTripID)
+	if err != nil {
+		api.logger.Error("failed to get trip's activities", zap.Error(err), zap.String("tripID", tripID))
+		return spec.PutTripsTripIDJSON400Response(spec.Error{
+			Message: "Algo deu errado, tente novamente mais tarde.",
+		})
+	}
+
+	updatedTrip := trip
+	updatedTrip.Destination = body.Destination
+	updatedTrip.StartsAt = pgtype.Timestamptz{Time: body.StartsAt, Valid: true}
+	updatedTrip.EndsAt = pgtype.Timestamptz{Time: body.EndsAt, Valid: true}
+	if body.TimeZone != nil {
+		updatedTrip.TimeZone = *body.TimeZone
+	}
+	if body.BaseCurrency != nil {
+		updatedTrip.BaseCurrency = *body.BaseCurrency
+	}
+
+	if updatedTrip.BaseCurrency != trip.BaseCurrency {
+		// amounts already converted would no longer add up with the new ones
+		locked, err := api.repository.TripHasExpensesOrBudgets(r.Context(), parsedTripID)
+		if err != nil {
+			api.logger.Error("failed to check trip's expenses", zap.Error(err), zap.String("tripID", tripID))
+			return spec.PutTripsTripIDJSON400Response(spec.Error{
+				Message: "Algo deu errado, tente novamente mais tarde.",
+			})
+		}
+
+		if locked {
+			return spec.PutTripsTripIDJSON400Response(spec.Error{
+				Message: "Input inválido.",
+				Errors: []spec.FieldError{{
+					Field:   "base_currency",
+					Message: "A moeda base não pode ser alterada depois que despesas ou orçamentos foram registrados.",
+				}},
+			})
+		}
+	}
+
+	var shift time.Duration
+	if params.Activities != nil && *params.Activities == "shift" {
+		shift = body.StartsAt.Sub(trip.StartsAt.Time)
+	}
+
+	// activities that would fall outside of the trip, after being shifted if the client asked for it
+	var orphaned []uuid.UUID
+	for _, activity := range activities {
+		if !isInsideTripWindow(updatedTrip, activity.OccursAt.Time.Add(shift)) {
+			orphaned = append(orphaned, activity.ID)
+		}
+	}
+
+	if len(orphaned) > 0 && (params.Activities == nil || *params.Activities != "drop") {
+		return spec.PutTripsTripIDJSON400Response(spec.Error{
+			Message: "As novas datas deixariam atividades fora da viagem.",
+			Errors: []spec.FieldError{{
+				Field: "activities",
+				Message: fmt.Sprintf(
+					"%d atividade(s) ficariam fora da viagem. Use activities=shift para movê-las junto com a viagem ou activities=drop para removê-las.",
+					len(orphaned),
+				),
+			}},
+		})
+	}
+
+	if err := api.repository.RescheduleTrip(r.Context(), api.pool, pgstore.UpdateTripParams{
+		Destination:  updatedTrip.Destination,
+		EndsAt:       updatedTrip.EndsAt,
+		StartsAt:     updatedTrip.StartsAt,
+		TimeZone:     updatedTrip.TimeZone,
+		BaseCurrency: updatedTrip.BaseCurrency,
+		ID:           parsedTripID,
+	}, shift, orphaned); err != nil {
+		api.logger.Error("failed to update trip", zap.Error(err), zap.String("tripID", tripID), zap.Any("body", body))
 
 		return spec.PutTripsTripIDJSON400Response(spec.Error{
 			Message: "Algo deu errado, tente novamente mais tarde.",
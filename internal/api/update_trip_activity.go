@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"time"
+)
+
+// PutTripsTripIDActivitiesActivityID Update a trip activity.
+// (PUT /trips/{tripId}/activities/{activityId})
+func (api API) PutTripsTripIDActivitiesActivityID(_ http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	var body spec.PutTripsTripIDActivitiesActivityIDJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(invalidInput(err))
+	}
+
+	if status, apiErr := api.updateActivity(r.Context(), tripID, activityID, &body.Title, &body.OccursAt); apiErr != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(*apiErr).Status(status)
+	}
+
+	return spec.PutTripsTripIDActivitiesActivityIDJSON204Response(struct{}{})
+}
+
+// PatchTripsTripIDActivitiesActivityID Partially update a trip activity.
+// (PATCH /trips/{tripId}/activities/{activityId})
+func (api API) PatchTripsTripIDActivitiesActivityID(_ http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	var body spec.PatchTripsTripIDActivitiesActivityIDJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(invalidInput(err))
+	}
+
+	if status, apiErr := api.updateActivity(r.Context(), tripID, activityID, body.Title, body.OccursAt); apiErr != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(*apiErr).Status(status)
+	}
+
+	return spec.PatchTripsTripIDActivitiesActivityIDJSON204Response(struct{}{})
+}
+
+// updateActivity changes the given fields of an activity, leaving the nil ones untouched.
+func (api API) updateActivity(ctx context.Context, _tripID string, _activityID string, title *string, occursAt *time.Time) (int, *spec.Error) {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return http.StatusBadRequest, &spec.Error{Message: "ID de viagem inválido."}
+	}
+
+	activityID, err := uuid.Parse(_activityID)
+	if err != nil {
+		return http.StatusBadRequest, &spec.Error{Message: "ID de atividade inválido."}
+	}
+
+	trip, status, apiErr := api.authorizeTripChange(ctx, tripID, memberAccess)
+	if apiErr != nil {
+		return status, apiErr
+	}
+
+	if occursAt != nil {
+		if apiErr := checkActivityDate(trip, *occursAt); apiErr != nil {
+			return http.StatusBadRequest, apiErr
+		}
+	}
+
+	params := pgstore.UpdateActivityParams{
+		Title:  stringToText(title),
+		ID:     activityID,
+		TripID: tripID,
+	}
+	if occursAt != nil {
+		params.OccursAt = pgtype.Timestamptz{Time: *occursAt, Valid: true}
+	}
+
+	// the trip is part of the filter, so activities of other trips are reported as not found
+	updated, err := api.repository.UpdateActivity(ctx, params)
+	if err != nil {
+		api.logger.Error("failed to update activity", zap.Error(err), zap.String("tripID", _tripID), zap.String("activityID", _activityID))
+		return http.StatusBadRequest, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	if updated == 0 {
+		return http.StatusBadRequest, &spec.Error{Message: "Atividade não encontrada."}
+	}
+
+	return http.StatusOK, nil
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+)
+
+// PutTripsTripIDLinksLinkID Update a trip link.
+// (PUT /trips/{tripId}/links/{linkId})
+func (api API) PutTripsTripIDLinksLinkID(_ http.ResponseWriter, r *http.Request, tripID string, linkID string) *spec.Response {
+	var body spec.PutTripsTripIDLinksLinkIDJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(invalidInput(err))
+	}
+
+	if status, apiErr := api.updateLink(r.Context(), tripID, linkID, &body.Title, &body.URL); apiErr != nil {
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(*apiErr).Status(status)
+	}
+
+	return spec.PutTripsTripIDLinksLinkIDJSON204Response(struct{}{})
+}
+
+// PatchTripsTripIDLinksLinkID Partially update a trip link.
+// (PATCH /trips/{tripId}/links/{linkId})
+func (api API) PatchTripsTripIDLinksLinkID(_ http.ResponseWriter, r *http.Request, tripID string, linkID string) *spec.Response {
+	var body spec.PatchTripsTripIDLinksLinkIDJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "JSON inválido: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(invalidInput(err))
+	}
+
+	if status, apiErr := api.updateLink(r.Context(), tripID, linkID, body.Title, body.URL); apiErr != nil {
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(*apiErr).Status(status)
+	}
+
+	return spec.PatchTripsTripIDLinksLinkIDJSON204Response(struct{}{})
+}
+
+// updateLink changes the given fields of a link, leaving the nil ones untouched.
+func (api API) updateLink(ctx context.Context, _tripID string, _linkID string, title *string, url *string) (int, *spec.Error) {
+	tripID, err := uuid.Parse(_tripID)
+	if err != nil {
+		return http.StatusBadRequest, &spec.Error{Message: "ID de viagem inválido."}
+	}
+
+	linkID, err := uuid.Parse(_linkID)
+	if err != nil {
+		return http.StatusBadRequest, &spec.Error{Message: "ID de link inválido."}
+	}
+
+	if _, status, apiErr := api.authorizeTripChange(ctx, tripID, memberAccess); apiErr != nil {
+		return status, apiErr
+	}
+
+	if url != nil {
+		normalized, err := NormalizeLinkURL(*url)
+		if err != nil {
+			apiErr := invalidLinkURL()
+			return http.StatusBadRequest, &apiErr
+		}
+		url = &normalized
+	}
+
+	// the trip is part of the filter, so links of other trips are reported as not found
+	updated, err := api.repository.UpdateLink(ctx, pgstore.UpdateLinkParams{
+		Title:  stringToText(title),
+		Url:    stringToText(url),
+		ID:     linkID,
+		TripID: tripID,
+	})
+	if err != nil {
+		if pgstore.IsUniqueViolation(err, pgstore.LinksTripURLConstraint) {
+			apiErr := duplicatedLink()
+			return http.StatusConflict, &apiErr
+		}
+
+		api.logger.Error("failed to update link", zap.Error(err), zap.String("tripID", _tripID), zap.String("linkID", _linkID))
+		return http.StatusBadRequest, &spec.Error{Message: "Algo deu errado, tente novamente mais tarde."}
+	}
+
+	if updated == 0 {
+		return http.StatusBadRequest, &spec.Error{Message: "Link não encontrado."}
+	}
+
+	return http.StatusOK, nil
+}
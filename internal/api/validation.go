@@ -0,0 +1,165 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/pgstore"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// newValidator creates the validator used on request bodies. On top of the go-playground rules, it knows the
+// journey's domain rules and reports fields by their JSON names, so errors can be shown next to the right input.
+func newValidator() *validator.Validate {
+	_validator := validator.New(validator.WithRequiredStructEnabled())
+
+	_validator.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	// future only accepts dates that haven't happened yet, such as the start of a new trip
+	if err := _validator.RegisterValidation("future", func(fl validator.FieldLevel) bool {
+		date, ok := fl.Field().Interface().(time.Time)
+		return ok && date.After(time.Now())
+	}); err != nil {
+		panic(err)
+	}
+
+	// weburl only accepts absolute http and https urls, unlike url and uri, which accept any scheme
+	if err := _validator.RegisterValidation("weburl", func(fl validator.FieldLevel) bool {
+		return isWebURL(fl.Field().String())
+	}); err != nil {
+		panic(err)
+	}
+
+	return _validator
+}
+
+// invalidInput turns a validation failure into an error listing what is wrong with each field.
+func invalidInput(err error) spec.Error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return spec.Error{Message: "Input inválido: " + err.Error()}
+	}
+
+	fields := make([]spec.FieldError, len(validationErrors))
+	for i, fieldError := range validationErrors {
+		// the namespace starts with the validated struct's type name, which means nothing to clients
+		_, field, _ := strings.Cut(fieldError.Namespace(), ".")
+		fields[i] = spec.FieldError{
+			Field:   field,
+			Message: fieldErrorMessage(fieldError),
+		}
+	}
+
+	return spec.Error{Message: "Input inválido.", Errors: fields}
+}
+
+func fieldErrorMessage(fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return "Campo obrigatório."
+	case "email":
+		return "E-mail inválido."
+	case "uri", "url":
+		return "URL inválida."
+	case "weburl":
+		return "URL inválida. Use um endereço começando com http:// ou https://."
+	case "uuid":
+		return "UUID inválido."
+	case "min":
+		if isNumber(fieldError.Kind()) {
+			return fmt.Sprintf("Deve ser no mínimo %s.", fieldError.Param())
+		}
+		return fmt.Sprintf("Deve ter no mínimo %s caracteres.", fieldError.Param())
+	case "max":
+		if isNumber(fieldError.Kind()) {
+			return fmt.Sprintf("Deve ser no máximo %s.", fieldError.Param())
+		}
+		return fmt.Sprintf("Deve ter no máximo %s caracteres.", fieldError.Param())
+	case "oneof":
+		return fmt.Sprintf("Deve ser um dos valores: %s.", strings.ReplaceAll(fieldError.Param(), " ", ", "))
+	case "iso4217":
+		return "Moeda inválida. Use um código ISO 4217, como BRL."
+	case "timezone":
+		return "Fuso horário inválido. Use um nome da base IANA, como America/Sao_Paulo."
+	case "datetime":
+		if fieldError.Param() == "15:04" {
+			return "Horário inválido. Use o formato hh:mm."
+		}
+		return "Data inválida."
+	case "future":
+		return "Deve ser uma data no futuro."
+	case "gtfield":
+		if fieldError.Param() == "StartsAt" {
+			return "Deve ser posterior à data de início."
+		}
+		return fmt.Sprintf("Deve ser maior que %s.", fieldError.Param())
+	case "nefield":
+		if fieldError.Param() == "FromEmail" {
+			return "Quem recebe deve ser diferente de quem paga."
+		}
+		return fmt.Sprintf("Deve ser diferente de %s.", fieldError.Param())
+	default:
+		return "Valor inválido."
+	}
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// tripLocation returns the trip's time zone. Time zones are validated before being saved, but UTC is used should
+// the server's time zone database not know it.
+func tripLocation(trip pgstore.Trip) *time.Location {
+	location, err := time.LoadLocation(trip.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// tripWindow returns the period in which the trip's activities may happen. It spans whole calendar days in the
+// trip's time zone, so activities on the last day of the trip are accepted no matter the time the trip ends.
+func tripWindow(trip pgstore.Trip) (time.Time, time.Time) {
+	location := tripLocation(trip)
+	return truncateToDay(trip.StartsAt.Time.In(location)), truncateToDay(trip.EndsAt.Time.In(location)).AddDate(0, 0, 1)
+}
+
+func isInsideTripWindow(trip pgstore.Trip, date time.Time) bool {
+	from, to := tripWindow(trip)
+	return !date.Before(from) && date.Before(to)
+}
+
+// checkActivityDate makes sure an activity is scheduled while the trip happens.
+func checkActivityDate(trip pgstore.Trip, occursAt time.Time) *spec.Error {
+	if isInsideTripWindow(trip, occursAt) {
+		return nil
+	}
+
+	return &spec.Error{
+		Message: "Input inválido.",
+		Errors: []spec.FieldError{{
+			Field: "occurs_at",
+			Message: fmt.Sprintf(
+				"A atividade deve acontecer durante a viagem, entre %s e %s.",
+				trip.StartsAt.Time.In(tripLocation(trip)).Format("02/01/2006"),
+				trip.EndsAt.Time.In(tripLocation(trip)).Format("02/01/2006"),
+			),
+		}},
+	}
+}
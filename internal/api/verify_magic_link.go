@@ -0,0 +1,48 @@
+package api
+
+import (
+	"github.com/discord-gophers/goapi-gen/types"
+	"go.uber.org/zap"
+	"net/http"
+	"nlw-journey/internal/api/spec"
+	"nlw-journey/internal/token"
+	"time"
+)
+
+const sessionTTL = 7 * 24 * time.Hour
+
+// GetAuthVerifyToken Show the e-mail a sign in link was sent to, without using it.
+// (GET /auth/verify/{token})
+// Mail scanners may open the link before its owner does, so the link is only exchanged for a session on POST.
+func (api API) GetAuthVerifyToken(_ http.ResponseWriter, r *http.Request, _token string) *spec.Response {
+	claims, _, apiErr := api.verifyUnusedToken(r.Context(), _token, token.PurposeLogin)
+	if apiErr != nil {
+		return spec.GetAuthVerifyTokenJSON400Response(*apiErr)
+	}
+
+	return spec.GetAuthVerifyTokenJSON200Response(struct {
+		Email types.Email `json:"email"`
+	}{
+		Email: types.Email(claims.Subject),
+	})
+}
+
+// PostAuthVerifyToken Exchange a sign in link for a session.
+// (POST /auth/verify/{token})
+func (api API) PostAuthVerifyToken(_ http.ResponseWriter, r *http.Request, _token string) *spec.Response {
+	claims, apiErr := api.consumeToken(r.Context(), _token, token.PurposeLogin)
+	if apiErr != nil {
+		return spec.PostAuthVerifyTokenJSON400Response(*apiErr)
+	}
+
+	session, err := api.signer.Sign(token.PurposeSession, claims.Subject, sessionTTL)
+	if err != nil {
+		api.logger.Error("failed to sign session token", zap.Error(err))
+		return spec.PostAuthVerifyTokenJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente mais tarde."})
+	}
+
+	return spec.PostAuthVerifyTokenJSON200Response(spec.Session{
+		Token:     session,
+		ExpiresAt: time.Now().Add(sessionTTL),
+	})
+}
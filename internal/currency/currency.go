@@ -0,0 +1,187 @@
+// Package currency converts amounts kept in minor units, such as cents, between currencies and reads the exchange
+// rate files loaded into the database.
+package currency
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DateFormat is the format of the dates in exchange rate files.
+const DateFormat = "2006-01-02"
+
+// minorUnits lists the currencies that don't have two decimal places, by their ISO 4217 code.
+var minorUnits = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// MinorUnits returns how many decimal places the currency has, which is how its amounts are scaled to minor units.
+func MinorUnits(code string) int {
+	if units, ok := minorUnits[code]; ok {
+		return units
+	}
+	return 2
+}
+
+// Convert turns amount, in minor units of from, into minor units of to. rate is how many units of to one unit of from
+// is worth. The result is rounded to the nearest minor unit, halves away from zero.
+func Convert(amount int64, from string, to string, rate *big.Rat) int64 {
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), rate)
+
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(MinorUnits(to)-MinorUnits(from)))), nil))
+	if MinorUnits(to) > MinorUnits(from) {
+		converted.Mul(converted, scale)
+	} else {
+		converted.Quo(converted, scale)
+	}
+
+	return round(converted)
+}
+
+func round(value *big.Rat) int64 {
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+
+	// the remainder has the sign of the numerator, so doubling it tells whether it is at least half way
+	doubled := new(big.Int).Abs(remainder)
+	doubled.Lsh(doubled, 1)
+	if doubled.Cmp(value.Denom()) >= 0 {
+		if value.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	return quotient.Int64()
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// Rate is an exchange rate: one unit of Base is worth Rate units of Quote, starting on EffectiveOn.
+type Rate struct {
+	Base        string
+	Quote       string
+	Rate        string
+	EffectiveOn time.Time
+}
+
+var codePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ErrUnknownFormat is returned when rates are read from a format other than CSV or JSON.
+var ErrUnknownFormat = errors.New("currency: rates must be in csv or json")
+
+// ReadRates reads exchange rates in the given format, "csv" or "json".
+//
+// CSV files have a header with the base, quote, rate and date columns, in any order. JSON files hold an array of
+// objects with the same keys. Dates are written as 2006-01-02 and rates as decimal numbers, such as 5.4321.
+func ReadRates(r io.Reader, format string) ([]Rate, error) {
+	var records []map[string]string
+
+	switch strings.ToLower(format) {
+	case "csv":
+		var err error
+		if records, err = readCSVRecords(r); err != nil {
+			return nil, err
+		}
+	case "json":
+		var entries []map[string]json.RawMessage
+		if err := json.NewDecoder(r).Decode(&entries); err != nil {
+			return nil, fmt.Errorf("currency: invalid json: %w", err)
+		}
+
+		for _, entry := range entries {
+			record := make(map[string]string, len(entry))
+			for key, raw := range entry {
+				// rates may be written as numbers or strings, other fields as strings
+				var value string
+				if err := json.Unmarshal(raw, &value); err != nil {
+					value = string(raw)
+				}
+				record[key] = value
+			}
+			records = append(records, record)
+		}
+	default:
+		return nil, ErrUnknownFormat
+	}
+
+	rates := make([]Rate, len(records))
+	for i, record := range records {
+		rate, err := parseRate(record)
+		if err != nil {
+			return nil, fmt.Errorf("currency: rate %d: %w", i+1, err)
+		}
+		rates[i] = rate
+	}
+
+	return rates, nil
+}
+
+func readCSVRecords(r io.Reader) ([]map[string]string, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("currency: failed to read csv header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+
+	var records []map[string]string
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("currency: invalid csv: %w", err)
+		}
+
+		record := make(map[string]string, len(header))
+		for i, column := range header {
+			record[column] = row[i]
+		}
+		records = append(records, record)
+	}
+}
+
+func parseRate(record map[string]string) (Rate, error) {
+	base := strings.ToUpper(strings.TrimSpace(record["base"]))
+	quote := strings.ToUpper(strings.TrimSpace(record["quote"]))
+	if !codePattern.MatchString(base) || !codePattern.MatchString(quote) {
+		return Rate{}, fmt.Errorf("invalid currencies %q and %q", record["base"], record["quote"])
+	}
+	if base == quote {
+		return Rate{}, fmt.Errorf("%s can't be converted to itself", base)
+	}
+
+	value := strings.TrimSpace(record["rate"])
+	parsed, ok := new(big.Rat).SetString(value)
+	if !ok || parsed.Sign() <= 0 || strings.ContainsAny(value, "/eE") {
+		return Rate{}, fmt.Errorf("invalid rate %q", record["rate"])
+	}
+
+	effectiveOn, err := time.Parse(DateFormat, strings.TrimSpace(record["date"]))
+	if err != nil {
+		return Rate{}, fmt.Errorf("invalid date %q", record["date"])
+	}
+
+	return Rate{Base: base, Quote: quote, Rate: value, EffectiveOn: effectiveOn}, nil
+}
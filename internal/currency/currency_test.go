@@ -0,0 +1,151 @@
+package currency
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		from   string
+		to     string
+		rate   string
+		want   int64
+	}{
+		{name: "same minor units", amount: 10000, from: "USD", to: "BRL", rate: "5.4321", want: 54321},
+		{name: "zero to two decimal places", amount: 1000, from: "JPY", to: "BRL", rate: "0.0365", want: 3650},
+		{name: "zero to two decimal places, rounded", amount: 1234, from: "JPY", to: "BRL", rate: "0.0365", want: 4504},
+		{name: "two to zero decimal places", amount: 10000, from: "BRL", to: "JPY", rate: "27.3972", want: 2740},
+		{name: "two to three decimal places", amount: 10000, from: "BRL", to: "KWD", rate: "0.0561", want: 5610},
+		{name: "two to three decimal places, rounded", amount: 12345, from: "BRL", to: "KWD", rate: "0.0561", want: 6926},
+		{name: "three to zero decimal places, half rounded up", amount: 1000, from: "KWD", to: "JPY", rate: "490.5", want: 491},
+		{name: "negative half rounded away from zero", amount: -1000, from: "KWD", to: "JPY", rate: "490.5", want: -491},
+		{name: "negative below half", amount: -7, from: "BRL", to: "BRL", rate: "0.3", want: -2},
+		{name: "zero", amount: 0, from: "JPY", to: "KWD", rate: "0.0061", want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rate, _ := new(big.Rat).SetString(test.rate)
+			if got := Convert(test.amount, test.from, test.to, rate); got != test.want {
+				t.Errorf("Convert(%d, %s, %s, %s) = %d, want %d", test.amount, test.from, test.to, test.rate, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		value *big.Rat
+		want  int64
+	}{
+		{big.NewRat(5, 2), 3},
+		{big.NewRat(-5, 2), -3},
+		{big.NewRat(7, 3), 2},
+		{big.NewRat(-7, 3), -2},
+		{big.NewRat(8, 3), 3},
+		{big.NewRat(-8, 3), -3},
+		{big.NewRat(1, 2), 1},
+		{big.NewRat(-1, 2), -1},
+		{big.NewRat(-1, 3), 0},
+		{big.NewRat(4, 1), 4},
+		{big.NewRat(0, 1), 0},
+	}
+
+	for _, test := range tests {
+		if got := round(test.value); got != test.want {
+			t.Errorf("round(%s) = %d, want %d", test.value, got, test.want)
+		}
+	}
+}
+
+func TestMinorUnits(t *testing.T) {
+	for code, want := range map[string]int{"BRL": 2, "USD": 2, "JPY": 0, "KWD": 3, "CLF": 4} {
+		if got := MinorUnits(code); got != want {
+			t.Errorf("MinorUnits(%s) = %d, want %d", code, got, want)
+		}
+	}
+}
+
+func TestReadRates(t *testing.T) {
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		format string
+		input  string
+		want   []Rate
+	}{
+		{
+			name:   "csv",
+			format: "csv",
+			input:  "base,quote,rate,date\nUSD,BRL,5.4321,2024-01-02\n",
+			want:   []Rate{{Base: "USD", Quote: "BRL", Rate: "5.4321", EffectiveOn: day}},
+		},
+		{
+			name:   "csv with reordered columns and lowercase codes",
+			format: "CSV",
+			input:  "Date, Rate, Quote, Base\n2024-01-02, 0.0061, kwd, jpy\n2024-01-02, 151.37, JPY, USD\n",
+			want: []Rate{
+				{Base: "JPY", Quote: "KWD", Rate: "0.0061", EffectiveOn: day},
+				{Base: "USD", Quote: "JPY", Rate: "151.37", EffectiveOn: day},
+			},
+		},
+		{
+			name:   "json with rates as numbers and strings",
+			format: "json",
+			input:  `[{"base": "USD", "quote": "BRL", "rate": 5.4321, "date": "2024-01-02"}, {"base": "EUR", "quote": "BRL", "rate": "5.9", "date": "2024-01-02"}]`,
+			want: []Rate{
+				{Base: "USD", Quote: "BRL", Rate: "5.4321", EffectiveOn: day},
+				{Base: "EUR", Quote: "BRL", Rate: "5.9", EffectiveOn: day},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ReadRates(strings.NewReader(test.input), test.format)
+			if err != nil {
+				t.Fatalf("ReadRates() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ReadRates() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestReadRatesRejectsInvalidRates(t *testing.T) {
+	rows := map[string]string{
+		"exponent":           "USD,BRL,1e3,2024-01-02",
+		"fraction":           "USD,BRL,1/2,2024-01-02",
+		"zero":               "USD,BRL,0,2024-01-02",
+		"negative":           "USD,BRL,-5.4321,2024-01-02",
+		"not a number":       "USD,BRL,abc,2024-01-02",
+		"same currency":      "BRL,BRL,1,2024-01-02",
+		"invalid currency":   "US,BRL,5.4321,2024-01-02",
+		"invalid date":       "USD,BRL,5.4321,02/01/2024",
+		"missing rate":       "USD,BRL,,2024-01-02",
+		"currency with sign": "USD,BR$,5.4321,2024-01-02",
+	}
+
+	for name, row := range rows {
+		t.Run(name, func(t *testing.T) {
+			if rates, err := ReadRates(strings.NewReader("base,quote,rate,date\n"+row+"\n"), "csv"); err == nil {
+				t.Errorf("ReadRates(%q) = %+v, want an error", row, rates)
+			}
+		})
+	}
+}
+
+func TestReadRatesRejectsUnknownFormats(t *testing.T) {
+	if _, err := ReadRates(strings.NewReader(""), "xml"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("ReadRates() error = %v, want %v", err, ErrUnknownFormat)
+	}
+}
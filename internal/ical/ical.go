@@ -0,0 +1,126 @@
+// Package ical writes iCalendar (RFC 5545) files.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Event statuses, as defined by RFC 5545.
+const (
+	StatusTentative = "TENTATIVE"
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405Z"
+
+	// maxLineOctets is the longest a content line may be, without its line break, before it must be folded.
+	maxLineOctets = 75
+)
+
+// Event is a VEVENT. All-day events only use the date of Start and End, and End is exclusive, so an all-day event
+// lasting a single day ends on the following day.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Status      string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+}
+
+// Calendar is a VCALENDAR holding events.
+type Calendar struct {
+	ProductID string
+	Name      string
+	TimeZone  string
+	Events    []Event
+}
+
+// Encode writes the calendar to w. stamp is used as the DTSTAMP of every event, and should be the time the
+// calendar was generated.
+func (calendar Calendar) Encode(w io.Writer, stamp time.Time) error {
+	writer := bufio.NewWriter(w)
+
+	line := func(name string, value string) {
+		writeLine(writer, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", calendar.ProductID)
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if calendar.Name != "" {
+		line("X-WR-CALNAME", escapeText(calendar.Name))
+	}
+	if calendar.TimeZone != "" {
+		line("X-WR-TIMEZONE", calendar.TimeZone)
+	}
+
+	for _, event := range calendar.Events {
+		line("BEGIN", "VEVENT")
+		line("UID", event.UID)
+		line("DTSTAMP", stamp.UTC().Format(dateTimeFormat))
+		if event.AllDay {
+			line("DTSTART;VALUE=DATE", event.Start.Format(dateFormat))
+			line("DTEND;VALUE=DATE", event.End.Format(dateFormat))
+		} else {
+			line("DTSTART", event.Start.UTC().Format(dateTimeFormat))
+			line("DTEND", event.End.UTC().Format(dateTimeFormat))
+		}
+		line("SUMMARY", escapeText(event.Summary))
+		if event.Description != "" {
+			line("DESCRIPTION", escapeText(event.Description))
+		}
+		if event.Status != "" {
+			line("STATUS", event.Status)
+		}
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+
+	return writer.Flush()
+}
+
+// escapeText escapes a TEXT value as described by RFC 5545, section 3.3.11.
+func escapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+// writeLine writes a content line ended by CRLF, folding it so that no line is longer than 75 octets. Folding never
+// splits a multi-byte character.
+func writeLine(writer *bufio.Writer, content string) {
+	limit := maxLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+
+		_, _ = writer.WriteString(content[:cut])
+		_, _ = writer.WriteString("\r\n ")
+		content = content[cut:]
+
+		// continuation lines start with a space, which counts towards the limit
+		limit = maxLineOctets - 1
+	}
+
+	_, _ = writer.WriteString(content)
+	_, _ = writer.WriteString("\r\n")
+}
@@ -0,0 +1,100 @@
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestEscapeText(t *testing.T) {
+	tests := map[string]string{
+		"Passeio de barco":            "Passeio de barco",
+		"Praia; trilha, museu":        `Praia\; trilha\, museu`,
+		`C:\roteiro`:                  `C:\\roteiro`,
+		"linha 1\nlinha 2":            `linha 1\nlinha 2`,
+		"linha 1\r\nlinha 2\rlinha 3": `linha 1\nlinha 2\nlinha 3`,
+		`\;`:                          `\\\;`,
+	}
+
+	for value, want := range tests {
+		if got := escapeText(value); got != want {
+			t.Errorf("escapeText(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestWriteLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "short line", content: "SUMMARY:Praia", want: "SUMMARY:Praia\r\n"},
+		{name: "exactly 75 octets", content: strings.Repeat("a", 75), want: strings.Repeat("a", 75) + "\r\n"},
+		{
+			name:    "folded once",
+			content: strings.Repeat("a", 80),
+			want:    strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 5) + "\r\n",
+		},
+		{
+			name:    "continuation lines count the leading space",
+			content: strings.Repeat("a", 75+74+1),
+			want:    strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 74) + "\r\n a\r\n",
+		},
+		{
+			// "ç" takes two octets and would be split at the 75th one
+			name:    "multi-byte character moved to the next line",
+			content: strings.Repeat("a", 74) + "ção",
+			want:    strings.Repeat("a", 74) + "\r\n ção\r\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			writer := bufio.NewWriter(&buffer)
+			writeLine(writer, test.content)
+			_ = writer.Flush()
+
+			if got := buffer.String(); got != test.want {
+				t.Errorf("writeLine(%q) = %q, want %q", test.content, got, test.want)
+			}
+		})
+	}
+}
+
+func TestWriteLineFoldsValidLines(t *testing.T) {
+	content := "DESCRIPTION:" + strings.Repeat("Viagem à praia 🏖️ com café. ", 20)
+
+	var buffer bytes.Buffer
+	writer := bufio.NewWriter(&buffer)
+	writeLine(writer, content)
+	_ = writer.Flush()
+
+	output := buffer.String()
+	if !strings.HasSuffix(output, "\r\n") {
+		t.Fatalf("writeLine() = %q, want it to end with CRLF", output)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(output, "\r\n"), "\r\n")
+	for i, line := range lines {
+		if len(line) > maxLineOctets {
+			t.Errorf("line %d has %d octets, want at most %d", i, len(line), maxLineOctets)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("line %d = %q splits a multi-byte character", i, line)
+		}
+		if i > 0 && !strings.HasPrefix(line, " ") {
+			t.Errorf("line %d = %q, want continuation lines to start with a space", i, line)
+		}
+		if strings.ContainsAny(line, "\r\n") {
+			t.Errorf("line %d = %q has a bare line break", i, line)
+		}
+	}
+
+	if unfolded := strings.ReplaceAll(strings.TrimSuffix(output, "\r\n"), "\r\n ", ""); unfolded != content {
+		t.Errorf("unfolding writeLine() = %q, want %q", unfolded, content)
+	}
+}
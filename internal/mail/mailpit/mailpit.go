@@ -9,13 +9,22 @@ import (
 	"go.uber.org/zap"
 	"html/template"
 	"nlw-journey/internal/pgstore"
+	"nlw-journey/internal/token"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
+const (
+	confirmTripTokenTTL        = 7 * 24 * time.Hour
+	confirmParticipantTokenTTL = 30 * 24 * time.Hour
+	loginTokenTTL              = 30 * time.Minute
+)
+
 type Database interface {
 	GetTrip(ctx context.Context, tripID uuid.UUID) (pgstore.Trip, error)
+	GetOpenChecklistItemsAssignedTo(ctx context.Context, arg pgstore.GetOpenChecklistItemsAssignedToParams) ([]pgstore.GetOpenChecklistItemsAssignedToRow, error)
 }
 
 type MailPit struct {
@@ -25,9 +34,11 @@ type MailPit struct {
 	host     string
 	username string
 	password string
+	apiURL   string
+	signer   token.Signer
 }
 
-func NewMailPit(pool *pgxpool.Pool, logger *zap.Logger) MailPit {
+func NewMailPit(pool *pgxpool.Pool, logger *zap.Logger, signer token.Signer) MailPit {
 	port, _ := strconv.Atoi(os.Getenv("MAILER_PORT"))
 
 	return MailPit{
@@ -37,6 +48,8 @@ func NewMailPit(pool *pgxpool.Pool, logger *zap.Logger) MailPit {
 		host:     os.Getenv("MAILER_HOST"),
 		username: os.Getenv("MAILER_USERNAME"),
 		password: os.Getenv("MAILER_PASSWORD"),
+		apiURL:   strings.TrimSuffix(os.Getenv("JOURNEY_API_URL"), "/"),
+		signer:   signer,
 	}
 }
 
@@ -95,7 +108,18 @@ func (mailPit MailPit) SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error {
 		return fmt.Errorf("MailPit: failed to render template: %w", err)
 	}
 
-	if err := msg.SetBodyHTMLTemplate(tmpl, trip); err != nil {
+	confirmationToken, err := mailPit.signer.Sign(token.PurposeConfirmTrip, trip.ID.String(), confirmTripTokenTTL)
+	if err != nil {
+		return fmt.Errorf("MailPit: failed to sign confirmation token: %w", err)
+	}
+
+	if err := msg.SetBodyHTMLTemplate(tmpl, struct {
+		pgstore.Trip
+		ConfirmationURL string
+	}{
+		Trip:            trip,
+		ConfirmationURL: fmt.Sprintf("%s/confirmations/trips/%s", mailPit.apiURL, confirmationToken),
+	}); err != nil {
 		return fmt.Errorf("MailPit: failed to set 'body' html template: %w", err)
 	}
 
@@ -113,6 +137,170 @@ func (mailPit MailPit) SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error {
 	return nil
 }
 
+func (mailPit MailPit) SendTripInvitationEmail(trip pgstore.Trip, participant pgstore.Participant) error {
+	var ctx = context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	openItems, err := mailPit.db.GetOpenChecklistItemsAssignedTo(ctx, pgstore.GetOpenChecklistItemsAssignedToParams{
+		TripID: trip.ID,
+		Email:  participant.Email,
+	})
+	if err != nil {
+		return fmt.Errorf("MailPit: failed to get open checklist items of %s: %w", participant.Email, err)
+	}
+
+	msg, err := mailPit.GenerateMsg("mailpit@jorney.com", participant.Email, fmt.Sprintf("Você foi convidado para uma viagem para %s.", trip.Destination))
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.ParseFiles("internal/mail/mailpit/invite.tmpl")
+	if err != nil {
+		return fmt.Errorf("MailPit: failed to render template: %w", err)
+	}
+
+	confirmationToken, err := mailPit.signer.Sign(token.PurposeConfirmParticipant, participant.ID.String(), confirmParticipantTokenTTL)
+	if err != nil {
+		return fmt.Errorf("MailPit: failed to sign confirmation token: %w", err)
+	}
+
+	if err := msg.SetBodyHTMLTemplate(tmpl, struct {
+		Trip            pgstore.Trip
+		Participant     pgstore.Participant
+		ConfirmationURL string
+		OpenItems       []pgstore.GetOpenChecklistItemsAssignedToRow
+	}{
+		Trip:            trip,
+		Participant:     participant,
+		ConfirmationURL: fmt.Sprintf("%s/confirmations/participants/%s", mailPit.apiURL, confirmationToken),
+		OpenItems:       openItems,
+	}); err != nil {
+		return fmt.Errorf("MailPit: failed to set 'body' html template: %w", err)
+	}
+
+	client, err := mailPit.GenerateClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.DialAndSend(msg); err != nil {
+		return fmt.Errorf("MailPit: failed to send mail: %w", err)
+	}
+
+	mailPit.logger.Info(fmt.Sprintf("MailPit: successfully sent invitation e-mail to %s.", participant.Email))
+
+	return nil
+}
+
+func (mailPit MailPit) SendMagicLinkEmail(email string) error {
+	msg, err := mailPit.GenerateMsg("mailpit@jorney.com", email, "Seu link de acesso ao plann.er")
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.ParseFiles("internal/mail/mailpit/magic_link.tmpl")
+	if err != nil {
+		return fmt.Errorf("MailPit: failed to render template: %w", err)
+	}
+
+	loginToken, err := mailPit.signer.Sign(token.PurposeLogin, email, loginTokenTTL)
+	if err != nil {
+		return fmt.Errorf("MailPit: failed to sign login token: %w", err)
+	}
+
+	if err := msg.SetBodyHTMLTemplate(tmpl, struct {
+		Email    string
+		LoginURL string
+	}{
+		Email:    email,
+		LoginURL: fmt.Sprintf("%s/auth/verify/%s", mailPit.apiURL, loginToken),
+	}); err != nil {
+		return fmt.Errorf("MailPit: failed to set 'body' html template: %w", err)
+	}
+
+	client, err := mailPit.GenerateClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.DialAndSend(msg); err != nil {
+		return fmt.Errorf("MailPit: failed to send mail: %w", err)
+	}
+
+	mailPit.logger.Info(fmt.Sprintf("MailPit: successfully sent magic link e-mail to %s.", email))
+
+	return nil
+}
+
+func (mailPit MailPit) SendParticipantRemovedEmail(trip pgstore.Trip, email string) error {
+	msg, err := mailPit.GenerateMsg("mailpit@jorney.com", email, fmt.Sprintf("Você foi removido da viagem para %s.", trip.Destination))
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.ParseFiles("internal/mail/mailpit/participant_removed.tmpl")
+	if err != nil {
+		return fmt.Errorf("MailPit: failed to render template: %w", err)
+	}
+
+	if err := msg.SetBodyHTMLTemplate(tmpl, struct {
+		Trip pgstore.Trip
+	}{
+		Trip: trip,
+	}); err != nil {
+		return fmt.Errorf("MailPit: failed to set 'body' html template: %w", err)
+	}
+
+	client, err := mailPit.GenerateClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.DialAndSend(msg); err != nil {
+		return fmt.Errorf("MailPit: failed to send mail: %w", err)
+	}
+
+	mailPit.logger.Info(fmt.Sprintf("MailPit: successfully sent removal e-mail to %s.", email))
+
+	return nil
+}
+
+func (mailPit MailPit) SendTripCancelledEmail(trip pgstore.Trip, participant pgstore.Participant) error {
+	msg, err := mailPit.GenerateMsg("mailpit@jorney.com", participant.Email, fmt.Sprintf("A viagem para %s foi cancelada.", trip.Destination))
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.ParseFiles("internal/mail/mailpit/trip_cancelled.tmpl")
+	if err != nil {
+		return fmt.Errorf("MailPit: failed to render template: %w", err)
+	}
+
+	if err := msg.SetBodyHTMLTemplate(tmpl, struct {
+		Trip        pgstore.Trip
+		Participant pgstore.Participant
+	}{
+		Trip:        trip,
+		Participant: participant,
+	}); err != nil {
+		return fmt.Errorf("MailPit: failed to set 'body' html template: %w", err)
+	}
+
+	client, err := mailPit.GenerateClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.DialAndSend(msg); err != nil {
+		return fmt.Errorf("MailPit: failed to send mail: %w", err)
+	}
+
+	mailPit.logger.Info(fmt.Sprintf("MailPit: successfully sent cancellation e-mail to %s.", participant.Email))
+
+	return nil
+}
+
 func (mailPit MailPit) GenerateMsg(from string, to string, subject string) (*mail.Msg, error) {
 	msg := mail.NewMsg()
 	if err := msg.From(from); err != nil {
@@ -0,0 +1,19 @@
+package pgstore
+
+import (
+	"errors"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	uniqueViolation = "23505"
+
+	// LinksTripURLConstraint keeps a trip from having the same url twice.
+	LinksTripURLConstraint = "links_trip_id_url_key"
+)
+
+// IsUniqueViolation reports whether err was caused by a row breaking the given unique constraint.
+func IsUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == constraint
+}
@@ -0,0 +1,42 @@
+package pgstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+// Kinds of e-mail the mail queue knows how to deliver.
+const (
+	MailKindConfirmTrip    = "confirm_trip"
+	MailKindTripConfirmed  = "trip_confirmed"
+	MailKindTripInvitation = "trip_invitation"
+	MailKindMagicLink      = "magic_link"
+	// MailKindParticipantRemoved is addressed to the payload's Email, since the participant row no longer
+	// exists when the e-mail is delivered.
+	MailKindParticipantRemoved = "participant_removed"
+	MailKindTripCancelled      = "trip_cancelled"
+)
+
+// MailPayload holds the references a queued e-mail needs to be rendered once the worker picks it up.
+type MailPayload struct {
+	TripID        uuid.UUID `json:"trip_id"`
+	ParticipantID uuid.UUID `json:"participant_id"`
+	Email         string    `json:"email,omitempty"`
+}
+
+// EnqueueMail stores an e-mail on the mail queue. Call it from a transaction-bound Queries so the e-mail is only
+// queued if the business change that triggered it is committed as well.
+func (selfQueries *Queries) EnqueueMail(ctx context.Context, kind string, payload MailPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to marshal mail payload: %w", err)
+	}
+
+	if err := selfQueries.EnqueueMailJob(ctx, EnqueueMailJobParams{Kind: kind, Payload: data}); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue %s mail: %w", kind, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+CREATE TYPE mail_job_status AS ENUM ('pending', 'sent', 'dead');
+
+CREATE TABLE IF NOT EXISTS mail_jobs (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "kind"          VARCHAR(255)                        NOT NULL,
+    "payload"       JSONB                               NOT NULL,
+    "status"        mail_job_status                     NOT NULL    DEFAULT 'pending',
+    "attempts"      INTEGER                             NOT NULL    DEFAULT 0,
+    "last_error"    TEXT,
+    "run_at"        TIMESTAMP                           NOT NULL    DEFAULT NOW(),
+    "locked_until"  TIMESTAMP,
+    "created_at"    TIMESTAMP                           NOT NULL    DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS mail_jobs_status_run_at_idx ON mail_jobs ("status", "run_at");
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS mail_jobs;
+DROP TYPE IF EXISTS mail_job_status;
@@ -0,0 +1,36 @@
+CREATE TYPE rsvp_status AS ENUM ('pending', 'confirmed', 'declined', 'maybe');
+
+ALTER TABLE participants
+    ADD COLUMN "status"         rsvp_status                         NOT NULL    DEFAULT 'pending',
+    ADD COLUMN "invited_at"     TIMESTAMP                           NOT NULL    DEFAULT NOW(),
+    ADD COLUMN "confirmed_at"   TIMESTAMP,
+    ADD COLUMN "declined_at"    TIMESTAMP,
+    ADD COLUMN "maybe_at"       TIMESTAMP;
+
+UPDATE participants
+SET
+    "status" = 'confirmed',
+    "confirmed_at" = NOW()
+WHERE
+    "is_confirmed" = TRUE;
+
+ALTER TABLE participants DROP COLUMN "is_confirmed";
+
+---- create above / drop below ----
+
+ALTER TABLE participants ADD COLUMN "is_confirmed" BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE participants
+SET
+    "is_confirmed" = TRUE
+WHERE
+    "status" = 'confirmed';
+
+ALTER TABLE participants
+    DROP COLUMN "status",
+    DROP COLUMN "invited_at",
+    DROP COLUMN "confirmed_at",
+    DROP COLUMN "declined_at",
+    DROP COLUMN "maybe_at";
+
+DROP TYPE IF EXISTS rsvp_status;
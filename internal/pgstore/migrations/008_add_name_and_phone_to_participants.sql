@@ -0,0 +1,9 @@
+ALTER TABLE participants
+    ADD COLUMN "name"   VARCHAR(255),
+    ADD COLUMN "phone"  VARCHAR(32);
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN "name",
+    DROP COLUMN "phone";
@@ -0,0 +1,7 @@
+-- NOT VALID keeps trips saved before this rule from blocking the migration, while still checking every new write.
+ALTER TABLE trips
+    ADD CONSTRAINT trips_ends_at_after_starts_at CHECK ("ends_at" > "starts_at") NOT VALID;
+
+---- create above / drop below ----
+
+ALTER TABLE trips DROP CONSTRAINT IF EXISTS trips_ends_at_after_starts_at;
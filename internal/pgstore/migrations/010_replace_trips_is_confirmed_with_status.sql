@@ -0,0 +1,29 @@
+CREATE TYPE trip_status AS ENUM ('draft', 'confirmed', 'cancelled', 'completed');
+
+ALTER TABLE trips ADD COLUMN "status" trip_status NOT NULL DEFAULT 'draft';
+
+UPDATE trips
+SET
+    "status" = CASE WHEN "ends_at" < NOW() THEN 'completed'::trip_status ELSE 'confirmed'::trip_status END
+WHERE
+    "is_confirmed" = TRUE;
+
+ALTER TABLE trips DROP COLUMN "is_confirmed";
+
+CREATE INDEX IF NOT EXISTS trips_confirmed_ends_at_idx ON trips ("ends_at") WHERE "status" = 'confirmed';
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS trips_confirmed_ends_at_idx;
+
+ALTER TABLE trips ADD COLUMN "is_confirmed" BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE trips
+SET
+    "is_confirmed" = TRUE
+WHERE
+    "status" IN ('confirmed', 'completed');
+
+ALTER TABLE trips DROP COLUMN "status";
+
+DROP TYPE IF EXISTS trip_status;
@@ -0,0 +1,9 @@
+CREATE INDEX IF NOT EXISTS trips_owner_email_idx ON trips (LOWER("owner_email"));
+CREATE INDEX IF NOT EXISTS trips_starts_at_id_idx ON trips ("starts_at", "id");
+CREATE INDEX IF NOT EXISTS participants_email_idx ON participants (LOWER("email"));
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS participants_email_idx;
+DROP INDEX IF EXISTS trips_starts_at_id_idx;
+DROP INDEX IF EXISTS trips_owner_email_idx;
@@ -0,0 +1,18 @@
+-- existing dates carry no offset, so they are read as UTC
+ALTER TABLE trips
+    ALTER COLUMN "starts_at" TYPE TIMESTAMPTZ USING "starts_at" AT TIME ZONE 'UTC',
+    ALTER COLUMN "ends_at" TYPE TIMESTAMPTZ USING "ends_at" AT TIME ZONE 'UTC',
+    ADD COLUMN "time_zone" VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+ALTER TABLE activities
+    ALTER COLUMN "occurs_at" TYPE TIMESTAMPTZ USING "occurs_at" AT TIME ZONE 'UTC';
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    ALTER COLUMN "occurs_at" TYPE TIMESTAMP USING "occurs_at" AT TIME ZONE 'UTC';
+
+ALTER TABLE trips
+    DROP COLUMN "time_zone",
+    ALTER COLUMN "starts_at" TYPE TIMESTAMP USING "starts_at" AT TIME ZONE 'UTC',
+    ALTER COLUMN "ends_at" TYPE TIMESTAMP USING "ends_at" AT TIME ZONE 'UTC';
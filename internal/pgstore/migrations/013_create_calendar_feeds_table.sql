@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "email"         VARCHAR(255)                        NOT NULL,
+    "token_hash"    BYTEA                               NOT NULL    UNIQUE,
+    "created_at"    TIMESTAMPTZ                         NOT NULL    DEFAULT NOW(),
+    "revoked_at"    TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS calendar_feeds_active_email_idx ON calendar_feeds (LOWER("email")) WHERE "revoked_at" IS NULL;
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS calendar_feeds;
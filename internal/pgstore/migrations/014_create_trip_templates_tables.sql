@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS trip_templates (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "owner_email"   VARCHAR(255)                        NOT NULL,
+    "name"          VARCHAR(255)                        NOT NULL,
+    "created_at"    TIMESTAMPTZ                         NOT NULL    DEFAULT NOW(),
+    "updated_at"    TIMESTAMPTZ                         NOT NULL    DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS trip_templates_owner_email_idx ON trip_templates (LOWER("owner_email"));
+
+CREATE TABLE IF NOT EXISTS trip_template_activities (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "template_id"   uuid                                NOT NULL,
+    "title"         VARCHAR(255)                        NOT NULL,
+    "day"           INTEGER                             NOT NULL    CHECK ("day" >= 1),
+    "time"          TIME                                NOT NULL,
+
+    FOREIGN KEY (template_id) REFERENCES trip_templates(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS trip_template_links (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "template_id"   uuid                                NOT NULL,
+    "title"         VARCHAR(255)                        NOT NULL,
+    "url"           VARCHAR(255)                        NOT NULL,
+
+    FOREIGN KEY (template_id) REFERENCES trip_templates(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS trip_template_links;
+DROP TABLE IF EXISTS trip_template_activities;
+DROP TABLE IF EXISTS trip_templates;
@@ -0,0 +1,22 @@
+ALTER TABLE links
+    ADD COLUMN IF NOT EXISTS "preview_title" TEXT,
+    ADD COLUMN IF NOT EXISTS "preview_description" TEXT,
+    ADD COLUMN IF NOT EXISTS "preview_image_url" TEXT,
+    ADD COLUMN IF NOT EXISTS "favicon_url" TEXT,
+    ADD COLUMN IF NOT EXISTS "unfurled_at" TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS "unfurl_locked_until" TIMESTAMPTZ;
+
+-- links created before previews existed are unfurled by the worker as well
+CREATE INDEX IF NOT EXISTS links_pending_unfurl_idx ON links ("id") WHERE "unfurled_at" IS NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS links_pending_unfurl_idx;
+
+ALTER TABLE links
+    DROP COLUMN IF EXISTS "unfurl_locked_until",
+    DROP COLUMN IF EXISTS "unfurled_at",
+    DROP COLUMN IF EXISTS "favicon_url",
+    DROP COLUMN IF EXISTS "preview_image_url",
+    DROP COLUMN IF EXISTS "preview_description",
+    DROP COLUMN IF EXISTS "preview_title";
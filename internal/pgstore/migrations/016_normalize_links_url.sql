@@ -0,0 +1,8 @@
+-- booking and map urls are often longer than 255 characters
+ALTER TABLE links ALTER COLUMN "url" TYPE VARCHAR(2048);
+ALTER TABLE trip_template_links ALTER COLUMN "url" TYPE VARCHAR(2048);
+
+---- create above / drop below ----
+
+ALTER TABLE trip_template_links ALTER COLUMN "url" TYPE VARCHAR(255);
+ALTER TABLE links ALTER COLUMN "url" TYPE VARCHAR(255);
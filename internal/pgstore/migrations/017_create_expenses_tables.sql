@@ -0,0 +1,47 @@
+CREATE TYPE expense_split_kind AS ENUM ('equal', 'shares', 'exact');
+
+CREATE TABLE IF NOT EXISTS expenses (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                                NOT NULL,
+    "activity_id"   uuid,
+    "payer_email"   VARCHAR(255)                        NOT NULL,
+    "description"   VARCHAR(255)                        NOT NULL,
+    -- amounts are kept in the currency's minor units, such as cents, so they are never rounded
+    "amount"        BIGINT                              NOT NULL    CHECK ("amount" > 0),
+    "currency"      CHAR(3)                             NOT NULL,
+    "split_kind"    expense_split_kind                  NOT NULL,
+    "created_at"    TIMESTAMPTZ                         NOT NULL    DEFAULT NOW(),
+    "updated_at"    TIMESTAMPTZ                         NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS expenses_trip_id_idx ON expenses ("trip_id", "created_at");
+
+-- participants are referenced by e-mail, like the trip owner, so expenses outlive participants removed from the trip
+CREATE TABLE IF NOT EXISTS expense_splits (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "expense_id"    uuid                                NOT NULL,
+    "email"         VARCHAR(255)                        NOT NULL,
+    "position"      INTEGER                             NOT NULL,
+    "shares"        INTEGER                                         CHECK ("shares" >= 1),
+    "amount"        BIGINT                              NOT NULL    CHECK ("amount" >= 0),
+
+    FOREIGN KEY (expense_id) REFERENCES expenses(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS expense_splits_expense_id_email_idx ON expense_splits ("expense_id", LOWER("email"));
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS expense_splits;
+DROP TABLE IF EXISTS expenses;
+DROP TYPE IF EXISTS expense_split_kind;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS settlements (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                                NOT NULL,
+    "from_email"    VARCHAR(255)                        NOT NULL,
+    "to_email"      VARCHAR(255)                        NOT NULL,
+    "amount"        BIGINT                              NOT NULL    CHECK ("amount" > 0),
+    "currency"      CHAR(3)                             NOT NULL,
+    "created_at"    TIMESTAMPTZ                         NOT NULL    DEFAULT NOW(),
+
+    CHECK (LOWER("from_email") <> LOWER("to_email")),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS settlements_trip_id_idx ON settlements ("trip_id", "created_at");
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS settlements;
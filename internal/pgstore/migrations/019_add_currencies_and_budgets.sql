@@ -0,0 +1,74 @@
+ALTER TABLE trips ADD COLUMN "base_currency" CHAR(3) NOT NULL DEFAULT 'BRL';
+
+-- trips that already have expenses use the currency of the first one as base, so most of their expenses convert as
+-- they are
+UPDATE trips
+SET
+    "base_currency" = first_expense.currency
+FROM (
+    SELECT DISTINCT ON (trip_id) trip_id, currency
+    FROM expenses
+    ORDER BY trip_id, created_at, id
+) first_expense
+WHERE
+    first_expense.trip_id = trips.id;
+
+-- one unit of base_currency is worth rate units of quote_currency, starting on effective_on
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    "base_currency"     CHAR(3)             NOT NULL,
+    "quote_currency"    CHAR(3)             NOT NULL,
+    "effective_on"      DATE                NOT NULL,
+    "rate"              NUMERIC(24, 12)     NOT NULL    CHECK ("rate" > 0),
+
+    PRIMARY KEY ("base_currency", "quote_currency", "effective_on"),
+    CHECK ("base_currency" <> "quote_currency")
+);
+
+-- the rate used to convert an expense is kept with it, so totals don't change when new rates are loaded. Expenses
+-- recorded before currencies were converted may have no rate.
+ALTER TABLE expenses
+    ADD COLUMN "category"       VARCHAR(32)         NOT NULL    DEFAULT 'other',
+    ADD COLUMN "base_currency"  CHAR(3),
+    ADD COLUMN "exchange_rate"  NUMERIC(24, 12),
+    ADD COLUMN "base_amount"    BIGINT;
+
+UPDATE expenses
+SET
+    "base_currency" = trips.base_currency,
+    "exchange_rate" = 1,
+    "base_amount" = expenses.amount
+FROM trips
+WHERE
+    trips.id = expenses.trip_id
+    AND trips.base_currency = expenses.currency;
+
+CREATE TABLE IF NOT EXISTS trip_budgets (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                                NOT NULL,
+    "category"      VARCHAR(32)                         NOT NULL,
+    "amount"        BIGINT                              NOT NULL    CHECK ("amount" > 0),
+    "currency"      CHAR(3)                             NOT NULL,
+    "base_currency" CHAR(3)                             NOT NULL,
+    "exchange_rate" NUMERIC(24, 12)                     NOT NULL,
+    "base_amount"   BIGINT                              NOT NULL,
+
+    UNIQUE ("trip_id", "category"),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS trip_budgets;
+
+ALTER TABLE expenses
+    DROP COLUMN "category",
+    DROP COLUMN "base_currency",
+    DROP COLUMN "exchange_rate",
+    DROP COLUMN "base_amount";
+
+DROP TABLE IF EXISTS exchange_rates;
+
+ALTER TABLE trips DROP COLUMN "base_currency";
@@ -0,0 +1,43 @@
+CREATE TYPE checklist_kind AS ENUM ('packing', 'todo');
+
+CREATE TABLE IF NOT EXISTS checklists (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                                NOT NULL,
+    "title"         VARCHAR(255)                        NOT NULL,
+    "kind"          checklist_kind                      NOT NULL,
+    "created_at"    TIMESTAMPTZ                         NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS checklists_trip_id_idx ON checklists ("trip_id", "created_at");
+
+CREATE TABLE IF NOT EXISTS checklist_items (
+    "id"                uuid            PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "checklist_id"      uuid                            NOT NULL,
+    "title"             VARCHAR(255)                    NOT NULL,
+    "group_name"        VARCHAR(255),
+    "assignee_email"    VARCHAR(255),
+    "is_done"           BOOLEAN                         NOT NULL    DEFAULT FALSE,
+    "done_at"           TIMESTAMPTZ,
+    "position"          INTEGER                         NOT NULL,
+    "created_at"        TIMESTAMPTZ                     NOT NULL    DEFAULT NOW(),
+
+    CHECK ("is_done" = ("done_at" IS NOT NULL)),
+
+    FOREIGN KEY (checklist_id) REFERENCES checklists(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS checklist_items_checklist_id_idx ON checklist_items ("checklist_id", "position");
+-- the invitation e-mail looks up the open items assigned to the invited e-mail
+CREATE INDEX IF NOT EXISTS checklist_items_assignee_email_idx ON checklist_items (LOWER("assignee_email")) WHERE NOT "is_done";
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS checklist_items;
+DROP TABLE IF EXISTS checklists;
+DROP TYPE IF EXISTS checklist_kind;
@@ -0,0 +1,65 @@
+-- proposals are kept apart from activities until the owner promotes them, so calendars, exports and clones only ever
+-- see scheduled activities
+CREATE TABLE IF NOT EXISTS activity_proposals (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                                NOT NULL,
+    "title"         VARCHAR(255)                        NOT NULL,
+    "occurs_at"     TIMESTAMPTZ,
+    "proposed_by"   VARCHAR(255)                        NOT NULL,
+    "activity_id"   uuid,
+    "promoted_at"   TIMESTAMPTZ,
+    "created_at"    TIMESTAMPTZ                         NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS activity_proposals_trip_id_idx ON activity_proposals ("trip_id", "created_at");
+
+-- proposals with options are polls, the others are voted up or down
+CREATE TABLE IF NOT EXISTS activity_proposal_options (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "proposal_id"   uuid                                NOT NULL,
+    "label"         VARCHAR(255)                        NOT NULL,
+    "occurs_at"     TIMESTAMPTZ,
+    "position"      INTEGER                             NOT NULL,
+
+    FOREIGN KEY (proposal_id) REFERENCES activity_proposals(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS activity_proposal_options_proposal_id_idx ON activity_proposal_options ("proposal_id", "position");
+
+CREATE TABLE IF NOT EXISTS activity_proposal_votes (
+    "id"            uuid                PRIMARY KEY     NOT NULL    DEFAULT gen_random_uuid(),
+    "proposal_id"   uuid                                NOT NULL,
+    "email"         VARCHAR(255)                        NOT NULL,
+    "value"         SMALLINT                                        CHECK ("value" IN (-1, 1)),
+    "option_id"     uuid,
+    "voted_at"      TIMESTAMPTZ                         NOT NULL    DEFAULT NOW(),
+
+    CHECK (("value" IS NULL) <> ("option_id" IS NULL)),
+
+    FOREIGN KEY (proposal_id) REFERENCES activity_proposals(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (option_id) REFERENCES activity_proposal_options(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+-- each member has a single vote per proposal, which is replaced when they vote again
+CREATE UNIQUE INDEX IF NOT EXISTS activity_proposal_votes_proposal_id_email_idx ON activity_proposal_votes ("proposal_id", LOWER("email"));
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS activity_proposal_votes;
+DROP TABLE IF EXISTS activity_proposal_options;
+DROP TABLE IF EXISTS activity_proposals;
@@ -0,0 +1,7 @@
+-- links saved before urls were normalized must be normalized first, with `journey normalize-links`, which also removes
+-- the links repeated in a trip, so this constraint holds for them as well
+ALTER TABLE links ADD CONSTRAINT links_trip_id_url_key UNIQUE ("trip_id", "url");
+
+---- create above / drop below ----
+
+ALTER TABLE links DROP CONSTRAINT IF EXISTS links_trip_id_url_key;
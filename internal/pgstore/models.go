@@ -5,37 +5,421 @@
 package pgstore
 
 import (
+	"database/sql/driver"
+	"fmt"
+
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ChecklistKind string
+
+const (
+	ChecklistKindPacking ChecklistKind = "packing"
+	ChecklistKindTodo    ChecklistKind = "todo"
+)
+
+func (e *ChecklistKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ChecklistKind(s)
+	case string:
+		*e = ChecklistKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ChecklistKind: %T", src)
+	}
+	return nil
+}
+
+type NullChecklistKind struct {
+	ChecklistKind ChecklistKind `json:"checklist_kind"`
+	Valid         bool          `json:"valid"` // Valid is true if ChecklistKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullChecklistKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.ChecklistKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ChecklistKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullChecklistKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ChecklistKind), nil
+}
+
+type ExpenseSplitKind string
+
+const (
+	ExpenseSplitKindEqual  ExpenseSplitKind = "equal"
+	ExpenseSplitKindShares ExpenseSplitKind = "shares"
+	ExpenseSplitKindExact  ExpenseSplitKind = "exact"
+)
+
+func (e *ExpenseSplitKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ExpenseSplitKind(s)
+	case string:
+		*e = ExpenseSplitKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ExpenseSplitKind: %T", src)
+	}
+	return nil
+}
+
+type NullExpenseSplitKind struct {
+	ExpenseSplitKind ExpenseSplitKind `json:"expense_split_kind"`
+	Valid            bool             `json:"valid"` // Valid is true if ExpenseSplitKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullExpenseSplitKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.ExpenseSplitKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ExpenseSplitKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullExpenseSplitKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ExpenseSplitKind), nil
+}
+
+type MailJobStatus string
+
+const (
+	MailJobStatusPending MailJobStatus = "pending"
+	MailJobStatusSent    MailJobStatus = "sent"
+	MailJobStatusDead    MailJobStatus = "dead"
+)
+
+func (e *MailJobStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = MailJobStatus(s)
+	case string:
+		*e = MailJobStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for MailJobStatus: %T", src)
+	}
+	return nil
+}
+
+type NullMailJobStatus struct {
+	MailJobStatus MailJobStatus `json:"mail_job_status"`
+	Valid         bool          `json:"valid"` // Valid is true if MailJobStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullMailJobStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.MailJobStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.MailJobStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullMailJobStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.MailJobStatus), nil
+}
+
+type RsvpStatus string
+
+const (
+	RsvpStatusPending   RsvpStatus = "pending"
+	RsvpStatusConfirmed RsvpStatus = "confirmed"
+	RsvpStatusDeclined  RsvpStatus = "declined"
+	RsvpStatusMaybe     RsvpStatus = "maybe"
+)
+
+func (e *RsvpStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RsvpStatus(s)
+	case string:
+		*e = RsvpStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RsvpStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRsvpStatus struct {
+	RsvpStatus RsvpStatus `json:"rsvp_status"`
+	Valid      bool       `json:"valid"` // Valid is true if RsvpStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRsvpStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RsvpStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RsvpStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRsvpStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RsvpStatus), nil
+}
+
+type TripStatus string
+
+const (
+	TripStatusDraft     TripStatus = "draft"
+	TripStatusConfirmed TripStatus = "confirmed"
+	TripStatusCancelled TripStatus = "cancelled"
+	TripStatusCompleted TripStatus = "completed"
+)
+
+func (e *TripStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TripStatus(s)
+	case string:
+		*e = TripStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TripStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTripStatus struct {
+	TripStatus TripStatus `json:"trip_status"`
+	Valid      bool       `json:"valid"` // Valid is true if TripStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTripStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TripStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TripStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTripStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TripStatus), nil
+}
+
 type Activity struct {
-	ID       uuid.UUID        `db:"id" json:"id"`
-	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
-	Title    string           `db:"title" json:"title"`
-	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	ID       uuid.UUID          `db:"id" json:"id"`
+	TripID   uuid.UUID          `db:"trip_id" json:"trip_id"`
+	Title    string             `db:"title" json:"title"`
+	OccursAt pgtype.Timestamptz `db:"occurs_at" json:"occurs_at"`
+}
+
+type ActivityProposal struct {
+	ID         uuid.UUID          `db:"id" json:"id"`
+	TripID     uuid.UUID          `db:"trip_id" json:"trip_id"`
+	Title      string             `db:"title" json:"title"`
+	OccursAt   pgtype.Timestamptz `db:"occurs_at" json:"occurs_at"`
+	ProposedBy string             `db:"proposed_by" json:"proposed_by"`
+	ActivityID pgtype.UUID        `db:"activity_id" json:"activity_id"`
+	PromotedAt pgtype.Timestamptz `db:"promoted_at" json:"promoted_at"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type ActivityProposalOption struct {
+	ID         uuid.UUID          `db:"id" json:"id"`
+	ProposalID uuid.UUID          `db:"proposal_id" json:"proposal_id"`
+	Label      string             `db:"label" json:"label"`
+	OccursAt   pgtype.Timestamptz `db:"occurs_at" json:"occurs_at"`
+	Position   int32              `db:"position" json:"position"`
+}
+
+type ActivityProposalVote struct {
+	ID         uuid.UUID          `db:"id" json:"id"`
+	ProposalID uuid.UUID          `db:"proposal_id" json:"proposal_id"`
+	Email      string             `db:"email" json:"email"`
+	Value      pgtype.Int2        `db:"value" json:"value"`
+	OptionID   pgtype.UUID        `db:"option_id" json:"option_id"`
+	VotedAt    pgtype.Timestamptz `db:"voted_at" json:"voted_at"`
+}
+
+type CalendarFeed struct {
+	ID        uuid.UUID          `db:"id" json:"id"`
+	Email     string             `db:"email" json:"email"`
+	TokenHash []byte             `db:"token_hash" json:"token_hash"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	RevokedAt pgtype.Timestamptz `db:"revoked_at" json:"revoked_at"`
+}
+
+type Checklist struct {
+	ID        uuid.UUID          `db:"id" json:"id"`
+	TripID    uuid.UUID          `db:"trip_id" json:"trip_id"`
+	Title     string             `db:"title" json:"title"`
+	Kind      ChecklistKind      `db:"kind" json:"kind"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type ChecklistItem struct {
+	ID            uuid.UUID          `db:"id" json:"id"`
+	ChecklistID   uuid.UUID          `db:"checklist_id" json:"checklist_id"`
+	Title         string             `db:"title" json:"title"`
+	GroupName     pgtype.Text        `db:"group_name" json:"group_name"`
+	AssigneeEmail pgtype.Text        `db:"assignee_email" json:"assignee_email"`
+	IsDone        bool               `db:"is_done" json:"is_done"`
+	DoneAt        pgtype.Timestamptz `db:"done_at" json:"done_at"`
+	Position      int32              `db:"position" json:"position"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type ExchangeRate struct {
+	BaseCurrency  string         `db:"base_currency" json:"base_currency"`
+	QuoteCurrency string         `db:"quote_currency" json:"quote_currency"`
+	EffectiveOn   pgtype.Date    `db:"effective_on" json:"effective_on"`
+	Rate          pgtype.Numeric `db:"rate" json:"rate"`
+}
+
+type Expense struct {
+	ID           uuid.UUID          `db:"id" json:"id"`
+	TripID       uuid.UUID          `db:"trip_id" json:"trip_id"`
+	ActivityID   pgtype.UUID        `db:"activity_id" json:"activity_id"`
+	PayerEmail   string             `db:"payer_email" json:"payer_email"`
+	Description  string             `db:"description" json:"description"`
+	Amount       int64              `db:"amount" json:"amount"`
+	Currency     string             `db:"currency" json:"currency"`
+	SplitKind    ExpenseSplitKind   `db:"split_kind" json:"split_kind"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Category     string             `db:"category" json:"category"`
+	BaseCurrency pgtype.Text        `db:"base_currency" json:"base_currency"`
+	ExchangeRate pgtype.Numeric     `db:"exchange_rate" json:"exchange_rate"`
+	BaseAmount   pgtype.Int8        `db:"base_amount" json:"base_amount"`
+}
+
+type ExpenseSplit struct {
+	ID        uuid.UUID   `db:"id" json:"id"`
+	ExpenseID uuid.UUID   `db:"expense_id" json:"expense_id"`
+	Email     string      `db:"email" json:"email"`
+	Position  int32       `db:"position" json:"position"`
+	Shares    pgtype.Int4 `db:"shares" json:"shares"`
+	Amount    int64       `db:"amount" json:"amount"`
 }
 
 type Link struct {
-	ID     uuid.UUID `db:"id" json:"id"`
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Title  string    `db:"title" json:"title"`
-	Url    string    `db:"url" json:"url"`
+	ID                 uuid.UUID          `db:"id" json:"id"`
+	TripID             uuid.UUID          `db:"trip_id" json:"trip_id"`
+	Title              string             `db:"title" json:"title"`
+	Url                string             `db:"url" json:"url"`
+	PreviewTitle       pgtype.Text        `db:"preview_title" json:"preview_title"`
+	PreviewDescription pgtype.Text        `db:"preview_description" json:"preview_description"`
+	PreviewImageUrl    pgtype.Text        `db:"preview_image_url" json:"preview_image_url"`
+	FaviconUrl         pgtype.Text        `db:"favicon_url" json:"favicon_url"`
+	UnfurledAt         pgtype.Timestamptz `db:"unfurled_at" json:"unfurled_at"`
+	UnfurlLockedUntil  pgtype.Timestamptz `db:"unfurl_locked_until" json:"unfurl_locked_until"`
+}
+
+type MailJob struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	Kind        string           `db:"kind" json:"kind"`
+	Payload     []byte           `db:"payload" json:"payload"`
+	Status      MailJobStatus    `db:"status" json:"status"`
+	Attempts    int32            `db:"attempts" json:"attempts"`
+	LastError   pgtype.Text      `db:"last_error" json:"last_error"`
+	RunAt       pgtype.Timestamp `db:"run_at" json:"run_at"`
+	LockedUntil pgtype.Timestamp `db:"locked_until" json:"locked_until"`
+	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
 }
 
 type Participant struct {
-	ID          uuid.UUID `db:"id" json:"id"`
-	TripID      uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email       string    `db:"email" json:"email"`
-	IsConfirmed bool      `db:"is_confirmed" json:"is_confirmed"`
+	ID          uuid.UUID        `db:"id" json:"id"`
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Email       string           `db:"email" json:"email"`
+	Status      RsvpStatus       `db:"status" json:"status"`
+	InvitedAt   pgtype.Timestamp `db:"invited_at" json:"invited_at"`
+	ConfirmedAt pgtype.Timestamp `db:"confirmed_at" json:"confirmed_at"`
+	DeclinedAt  pgtype.Timestamp `db:"declined_at" json:"declined_at"`
+	MaybeAt     pgtype.Timestamp `db:"maybe_at" json:"maybe_at"`
+	Name        pgtype.Text      `db:"name" json:"name"`
+	Phone       pgtype.Text      `db:"phone" json:"phone"`
+}
+
+type Settlement struct {
+	ID        uuid.UUID          `db:"id" json:"id"`
+	TripID    uuid.UUID          `db:"trip_id" json:"trip_id"`
+	FromEmail string             `db:"from_email" json:"from_email"`
+	ToEmail   string             `db:"to_email" json:"to_email"`
+	Amount    int64              `db:"amount" json:"amount"`
+	Currency  string             `db:"currency" json:"currency"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
 }
 
 type Trip struct {
-	ID          uuid.UUID        `db:"id" json:"id"`
-	Destination string           `db:"destination" json:"destination"`
-	OwnerEmail  string           `db:"owner_email" json:"owner_email"`
-	OwnerName   string           `db:"owner_name" json:"owner_name"`
-	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	ID           uuid.UUID          `db:"id" json:"id"`
+	Destination  string             `db:"destination" json:"destination"`
+	OwnerEmail   string             `db:"owner_email" json:"owner_email"`
+	OwnerName    string             `db:"owner_name" json:"owner_name"`
+	StartsAt     pgtype.Timestamptz `db:"starts_at" json:"starts_at"`
+	EndsAt       pgtype.Timestamptz `db:"ends_at" json:"ends_at"`
+	Status       TripStatus         `db:"status" json:"status"`
+	TimeZone     string             `db:"time_zone" json:"time_zone"`
+	BaseCurrency string             `db:"base_currency" json:"base_currency"`
+}
+
+type TripBudget struct {
+	ID           uuid.UUID      `db:"id" json:"id"`
+	TripID       uuid.UUID      `db:"trip_id" json:"trip_id"`
+	Category     string         `db:"category" json:"category"`
+	Amount       int64          `db:"amount" json:"amount"`
+	Currency     string         `db:"currency" json:"currency"`
+	BaseCurrency string         `db:"base_currency" json:"base_currency"`
+	ExchangeRate pgtype.Numeric `db:"exchange_rate" json:"exchange_rate"`
+	BaseAmount   int64          `db:"base_amount" json:"base_amount"`
+}
+
+type TripTemplate struct {
+	ID         uuid.UUID          `db:"id" json:"id"`
+	OwnerEmail string             `db:"owner_email" json:"owner_email"`
+	Name       string             `db:"name" json:"name"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type TripTemplateActivity struct {
+	ID         uuid.UUID   `db:"id" json:"id"`
+	TemplateID uuid.UUID   `db:"template_id" json:"template_id"`
+	Title      string      `db:"title" json:"title"`
+	Day        int32       `db:"day" json:"day"`
+	Time       pgtype.Time `db:"time" json:"time"`
+}
+
+type TripTemplateLink struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	TemplateID uuid.UUID `db:"template_id" json:"template_id"`
+	Title      string    `db:"title" json:"title"`
+	Url        string    `db:"url" json:"url"`
+}
+
+type UsedToken struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	ExpiresAt pgtype.Timestamp `db:"expires_at" json:"expires_at"`
+	UsedAt    pgtype.Timestamp `db:"used_at" json:"used_at"`
 }
@@ -12,16 +12,181 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const confirmParticipant = `-- name: ConfirmParticipant :exec
+const claimLinksToUnfurl = `-- name: ClaimLinksToUnfurl :many
+UPDATE links
+SET
+    "unfurl_locked_until" = NOW() + INTERVAL '1 minute'
+WHERE id IN (
+    SELECT id
+    FROM links
+    WHERE
+        unfurled_at IS NULL
+        AND (unfurl_locked_until IS NULL OR unfurl_locked_until < NOW())
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING "id", "url"
+`
+
+type ClaimLinksToUnfurlRow struct {
+	ID  uuid.UUID `db:"id" json:"id"`
+	Url string    `db:"url" json:"url"`
+}
+
+func (q *Queries) ClaimLinksToUnfurl(ctx context.Context, limit int32) ([]ClaimLinksToUnfurlRow, error) {
+	rows, err := q.db.Query(ctx, claimLinksToUnfurl, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimLinksToUnfurlRow
+	for rows.Next() {
+		var i ClaimLinksToUnfurlRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimMailJobs = `-- name: ClaimMailJobs :many
+UPDATE mail_jobs
+SET
+    "attempts" = "attempts" + 1,
+    "locked_until" = NOW() + INTERVAL '5 minutes'
+WHERE id IN (
+    SELECT id
+    FROM mail_jobs
+    WHERE
+        status = 'pending'
+        AND run_at <= NOW()
+        AND (locked_until IS NULL OR locked_until < NOW())
+    ORDER BY run_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING "id", "kind", "payload", "status", "attempts", "last_error", "run_at", "locked_until", "created_at"
+`
+
+func (q *Queries) ClaimMailJobs(ctx context.Context, limit int32) ([]MailJob, error) {
+	rows, err := q.db.Query(ctx, claimMailJobs, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MailJob
+	for rows.Next() {
+		var i MailJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.RunAt,
+			&i.LockedUntil,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeEndedTrips = `-- name: CompleteEndedTrips :execrows
+UPDATE trips
+SET
+    "status" = 'completed'
+WHERE
+    "status" = 'confirmed'
+    AND "ends_at" < NOW()
+`
+
+func (q *Queries) CompleteEndedTrips(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, completeEndedTrips)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const copyTripActivities = `-- name: CopyTripActivities :exec
+INSERT INTO activities
+    ("trip_id", "title", "occurs_at")
+SELECT
+    $1, "title",
+    (("occurs_at" AT TIME ZONE $2::text) + $3::integer * INTERVAL '1 day') AT TIME ZONE $2::text
+FROM activities
+WHERE
+    trip_id = $4
+`
+
+type CopyTripActivitiesParams struct {
+	NewTripID uuid.UUID `db:"new_trip_id" json:"new_trip_id"`
+	TimeZone  string    `db:"time_zone" json:"time_zone"`
+	Days      int32     `db:"days" json:"days"`
+	TripID    uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) CopyTripActivities(ctx context.Context, arg CopyTripActivitiesParams) error {
+	_, err := q.db.Exec(ctx, copyTripActivities,
+		arg.NewTripID,
+		arg.TimeZone,
+		arg.Days,
+		arg.TripID,
+	)
+	return err
+}
+
+const copyTripLinks = `-- name: CopyTripLinks :exec
+INSERT INTO links
+    ("trip_id", "title", "url")
+SELECT
+    $1, "title", "url"
+FROM links
+WHERE
+    trip_id = $2
+`
+
+type CopyTripLinksParams struct {
+	NewTripID uuid.UUID `db:"new_trip_id" json:"new_trip_id"`
+	TripID    uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) CopyTripLinks(ctx context.Context, arg CopyTripLinksParams) error {
+	_, err := q.db.Exec(ctx, copyTripLinks, arg.NewTripID, arg.TripID)
+	return err
+}
+
+const copyTripParticipants = `-- name: CopyTripParticipants :exec
+INSERT INTO participants
+    ("trip_id", "email", "name", "phone")
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    $1, "email", "name", "phone"
 FROM participants
 WHERE
-    id = $1
+    trip_id = $2
 `
 
-func (q *Queries) ConfirmParticipant(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, confirmParticipant, id)
+type CopyTripParticipantsParams struct {
+	NewTripID uuid.UUID `db:"new_trip_id" json:"new_trip_id"`
+	TripID    uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) CopyTripParticipants(ctx context.Context, arg CopyTripParticipantsParams) error {
+	_, err := q.db.Exec(ctx, copyTripParticipants, arg.NewTripID, arg.TripID)
 	return err
 }
 
@@ -33,9 +198,9 @@ RETURNING "id"
 `
 
 type CreateActivityParams struct {
-	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
-	Title    string           `db:"title" json:"title"`
-	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	TripID   uuid.UUID          `db:"trip_id" json:"trip_id"`
+	Title    string             `db:"title" json:"title"`
+	OccursAt pgtype.Timestamptz `db:"occurs_at" json:"occurs_at"`
 }
 
 func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
@@ -45,6 +210,25 @@ func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams)
 	return id, err
 }
 
+const createCalendarFeed = `-- name: CreateCalendarFeed :one
+INSERT INTO calendar_feeds
+    ("email", "token_hash") VALUES
+    ($1, $2)
+RETURNING "id"
+`
+
+type CreateCalendarFeedParams struct {
+	Email     string `db:"email" json:"email"`
+	TokenHash []byte `db:"token_hash" json:"token_hash"`
+}
+
+func (q *Queries) CreateCalendarFeed(ctx context.Context, arg CreateCalendarFeedParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createCalendarFeed, arg.Email, arg.TokenHash)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const createTripLink = `-- name: CreateTripLink :one
 INSERT INTO links
 ( "trip_id", "title", "url" ) VALUES
@@ -65,207 +249,2666 @@ func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams)
 	return id, err
 }
 
-const getParticipant = `-- name: GetParticipant :one
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+const deleteActivity = `-- name: DeleteActivity :execrows
+DELETE FROM activities
 WHERE
     id = $1
+    AND trip_id = $2
 `
 
-func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
-	row := q.db.QueryRow(ctx, getParticipant, id)
-	var i Participant
-	err := row.Scan(
-		&i.ID,
-		&i.TripID,
-		&i.Email,
-		&i.IsConfirmed,
-	)
-	return i, err
+type DeleteActivityParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
 }
 
-const getParticipants = `-- name: GetParticipants :many
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+func (q *Queries) DeleteActivity(ctx context.Context, arg DeleteActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteActivity, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteActivityProposal = `-- name: DeleteActivityProposal :execrows
+DELETE FROM activity_proposals
 WHERE
-    trip_id = $1
+    id = $1
+    AND trip_id = $2
 `
 
-func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
-	rows, err := q.db.Query(ctx, getParticipants, tripID)
+type DeleteActivityProposalParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteActivityProposal(ctx context.Context, arg DeleteActivityProposalParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteActivityProposal, arg.ID, arg.TripID)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Participant
-	for rows.Next() {
-		var i Participant
-		if err := rows.Scan(
-			&i.ID,
-			&i.TripID,
-			&i.Email,
-			&i.IsConfirmed,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const getTrip = `-- name: GetTrip :one
-SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
-FROM trips
+const deleteActivityProposalVote = `-- name: DeleteActivityProposalVote :execrows
+DELETE FROM activity_proposal_votes
 WHERE
-    id = $1
+    proposal_id = $1
+    AND LOWER(email) = LOWER($2)
 `
 
-func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
-	row := q.db.QueryRow(ctx, getTrip, id)
-	var i Trip
-	err := row.Scan(
-		&i.ID,
-		&i.Destination,
-		&i.OwnerEmail,
-		&i.OwnerName,
-		&i.IsConfirmed,
-		&i.StartsAt,
-		&i.EndsAt,
-	)
-	return i, err
+type DeleteActivityProposalVoteParams struct {
+	ProposalID uuid.UUID `db:"proposal_id" json:"proposal_id"`
+	Email      string    `db:"email" json:"email"`
 }
 
-const getTripActivities = `-- name: GetTripActivities :many
-SELECT
-    "id", "trip_id", "title", "occurs_at"
-FROM activities
+func (q *Queries) DeleteActivityProposalVote(ctx context.Context, arg DeleteActivityProposalVoteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteActivityProposalVote, arg.ProposalID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteChecklist = `-- name: DeleteChecklist :execrows
+DELETE FROM checklists
 WHERE
-    trip_id = $1
+    id = $1
+    AND trip_id = $2
 `
 
-func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
-	rows, err := q.db.Query(ctx, getTripActivities, tripID)
+type DeleteChecklistParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteChecklist(ctx context.Context, arg DeleteChecklistParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteChecklist, arg.ID, arg.TripID)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Activity
-	for rows.Next() {
-		var i Activity
-		if err := rows.Scan(
-			&i.ID,
-			&i.TripID,
-			&i.Title,
-			&i.OccursAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const getTripLinks = `-- name: GetTripLinks :many
-SELECT
-    "id", "trip_id", "title", "url"
-FROM links
+const deleteChecklistItem = `-- name: DeleteChecklistItem :execrows
+DELETE FROM checklist_items
 WHERE
-    trip_id = $1
+    id = $1
+    AND checklist_id = $2
 `
 
-func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
-	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+type DeleteChecklistItemParams struct {
+	ID          uuid.UUID `db:"id" json:"id"`
+	ChecklistID uuid.UUID `db:"checklist_id" json:"checklist_id"`
+}
+
+func (q *Queries) DeleteChecklistItem(ctx context.Context, arg DeleteChecklistItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteChecklistItem, arg.ID, arg.ChecklistID)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Link
-	for rows.Next() {
-		var i Link
-		if err := rows.Scan(
-			&i.ID,
-			&i.TripID,
-			&i.Title,
-			&i.Url,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const insertTrip = `-- name: InsertTrip :one
-INSERT INTO trips
-    ("destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ($1, $2, $3, $4, $5)
-RETURNING "id"
+const deleteExpense = `-- name: DeleteExpense :execrows
+DELETE FROM expenses
+WHERE
+    id = $1
+    AND trip_id = $2
 `
 
-type InsertTripParams struct {
-	Destination string           `db:"destination" json:"destination"`
-	OwnerEmail  string           `db:"owner_email" json:"owner_email"`
-	OwnerName   string           `db:"owner_name" json:"owner_name"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+type DeleteExpenseParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
 }
 
-func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, insertTrip,
-		arg.Destination,
-		arg.OwnerEmail,
-		arg.OwnerName,
-		arg.StartsAt,
-		arg.EndsAt,
-	)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) DeleteExpense(ctx context.Context, arg DeleteExpenseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpense, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-type InviteParticipantsToTripParams struct {
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email  string    `db:"email" json:"email"`
+const deleteExpenseSplits = `-- name: DeleteExpenseSplits :exec
+DELETE FROM expense_splits
+WHERE
+    expense_id = $1
+`
+
+func (q *Queries) DeleteExpenseSplits(ctx context.Context, expenseID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteExpenseSplits, expenseID)
+	return err
 }
 
-const updateTrip = `-- name: UpdateTrip :exec
-UPDATE trips
-SET
-    "destination" = $1,
-    "ends_at" = $2,
-    "starts_at" = $3,
-    "is_confirmed" = $4
+const deleteLink = `-- name: DeleteLink :execrows
+DELETE FROM links
 WHERE
-    id = $5
+    id = $1
+    AND trip_id = $2
 `
 
-type UpdateTripParams struct {
-	Destination string           `db:"destination" json:"destination"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
-	ID          uuid.UUID        `db:"id" json:"id"`
+type DeleteLinkParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
 }
 
-func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
-	_, err := q.db.Exec(ctx, updateTrip,
-		arg.Destination,
+func (q *Queries) DeleteLink(ctx context.Context, arg DeleteLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteLink, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteParticipant = `-- name: DeleteParticipant :execrows
+DELETE FROM participants
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type DeleteParticipantParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteParticipant(ctx context.Context, arg DeleteParticipantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteParticipant, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSettlement = `-- name: DeleteSettlement :execrows
+DELETE FROM settlements
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type DeleteSettlementParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteSettlement(ctx context.Context, arg DeleteSettlementParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSettlement, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTrip = `-- name: DeleteTrip :execrows
+DELETE FROM trips
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteTrip(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTrip, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTripActivities = `-- name: DeleteTripActivities :execrows
+DELETE FROM activities
+WHERE
+    trip_id = $1
+    AND id = ANY($2::uuid[])
+`
+
+type DeleteTripActivitiesParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Ids    []uuid.UUID `db:"ids" json:"ids"`
+}
+
+func (q *Queries) DeleteTripActivities(ctx context.Context, arg DeleteTripActivitiesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTripActivities, arg.TripID, arg.Ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTripBudgets = `-- name: DeleteTripBudgets :exec
+DELETE FROM trip_budgets
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) DeleteTripBudgets(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTripBudgets, tripID)
+	return err
+}
+
+const deleteTripTemplate = `-- name: DeleteTripTemplate :execrows
+DELETE FROM trip_templates
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteTripTemplate(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTripTemplate, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTripTemplateActivities = `-- name: DeleteTripTemplateActivities :exec
+DELETE FROM trip_template_activities
+WHERE
+    template_id = $1
+`
+
+func (q *Queries) DeleteTripTemplateActivities(ctx context.Context, templateID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTripTemplateActivities, templateID)
+	return err
+}
+
+const deleteTripTemplateLinks = `-- name: DeleteTripTemplateLinks :exec
+DELETE FROM trip_template_links
+WHERE
+    template_id = $1
+`
+
+func (q *Queries) DeleteTripTemplateLinks(ctx context.Context, templateID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTripTemplateLinks, templateID)
+	return err
+}
+
+const enqueueMailJob = `-- name: EnqueueMailJob :exec
+INSERT INTO mail_jobs
+( "kind", "payload" ) VALUES
+    ( $1, $2 )
+`
+
+type EnqueueMailJobParams struct {
+	Kind    string `db:"kind" json:"kind"`
+	Payload []byte `db:"payload" json:"payload"`
+}
+
+func (q *Queries) EnqueueMailJob(ctx context.Context, arg EnqueueMailJobParams) error {
+	_, err := q.db.Exec(ctx, enqueueMailJob, arg.Kind, arg.Payload)
+	return err
+}
+
+const getActivitiesOfTrips = `-- name: GetActivitiesOfTrips :many
+SELECT
+    "id", "trip_id", "title", "occurs_at"
+FROM activities
+WHERE
+    trip_id = ANY($1::uuid[])
+ORDER BY "trip_id" ASC, "occurs_at" ASC, "id" ASC
+`
+
+func (q *Queries) GetActivitiesOfTrips(ctx context.Context, tripIds []uuid.UUID) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getActivitiesOfTrips, tripIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActivityProposal = `-- name: GetActivityProposal :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "proposed_by", "activity_id", "promoted_at", "created_at"
+FROM activity_proposals
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type GetActivityProposalParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) GetActivityProposal(ctx context.Context, arg GetActivityProposalParams) (ActivityProposal, error) {
+	row := q.db.QueryRow(ctx, getActivityProposal, arg.ID, arg.TripID)
+	var i ActivityProposal
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.ProposedBy,
+		&i.ActivityID,
+		&i.PromotedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getActivityProposalOptions = `-- name: GetActivityProposalOptions :many
+SELECT
+    "id", "proposal_id", "label", "occurs_at", "position"
+FROM activity_proposal_options
+WHERE
+    proposal_id = $1
+ORDER BY position
+`
+
+func (q *Queries) GetActivityProposalOptions(ctx context.Context, proposalID uuid.UUID) ([]ActivityProposalOption, error) {
+	rows, err := q.db.Query(ctx, getActivityProposalOptions, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActivityProposalOption
+	for rows.Next() {
+		var i ActivityProposalOption
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProposalID,
+			&i.Label,
+			&i.OccursAt,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllLinksForUpdate = `-- name: GetAllLinksForUpdate :many
+SELECT
+    "id", "trip_id", "title", "url", "preview_title", "preview_description", "preview_image_url", "favicon_url", "unfurled_at", "unfurl_locked_until"
+FROM links
+ORDER BY "trip_id" ASC, "id" ASC
+FOR UPDATE
+`
+
+func (q *Queries) GetAllLinksForUpdate(ctx context.Context) ([]Link, error) {
+	rows, err := q.db.Query(ctx, getAllLinksForUpdate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+			&i.PreviewTitle,
+			&i.PreviewDescription,
+			&i.PreviewImageUrl,
+			&i.FaviconUrl,
+			&i.UnfurledAt,
+			&i.UnfurlLockedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCalendarFeedByTokenHash = `-- name: GetCalendarFeedByTokenHash :one
+SELECT
+    "id", "email", "token_hash", "created_at", "revoked_at"
+FROM calendar_feeds
+WHERE
+    token_hash = $1
+    AND revoked_at IS NULL
+`
+
+func (q *Queries) GetCalendarFeedByTokenHash(ctx context.Context, tokenHash []byte) (CalendarFeed, error) {
+	row := q.db.QueryRow(ctx, getCalendarFeedByTokenHash, tokenHash)
+	var i CalendarFeed
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getChecklistItems = `-- name: GetChecklistItems :many
+SELECT
+    "id", "checklist_id", "title", "group_name", "assignee_email", "is_done", "done_at", "position", "created_at"
+FROM checklist_items
+WHERE
+    checklist_id = $1
+ORDER BY position, created_at, id
+`
+
+func (q *Queries) GetChecklistItems(ctx context.Context, checklistID uuid.UUID) ([]ChecklistItem, error) {
+	rows, err := q.db.Query(ctx, getChecklistItems, checklistID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChecklistItem
+	for rows.Next() {
+		var i ChecklistItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChecklistID,
+			&i.Title,
+			&i.GroupName,
+			&i.AssigneeEmail,
+			&i.IsDone,
+			&i.DoneAt,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeadMailJobs = `-- name: GetDeadMailJobs :many
+SELECT
+    "id", "kind", "payload", "status", "attempts", "last_error", "run_at", "locked_until", "created_at"
+FROM mail_jobs
+WHERE
+    status = 'dead'
+ORDER BY created_at
+`
+
+func (q *Queries) GetDeadMailJobs(ctx context.Context) ([]MailJob, error) {
+	rows, err := q.db.Query(ctx, getDeadMailJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MailJob
+	for rows.Next() {
+		var i MailJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.RunAt,
+			&i.LockedUntil,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExchangeRate = `-- name: GetExchangeRate :one
+SELECT
+    "base_currency", "quote_currency", "effective_on", "rate"
+FROM exchange_rates
+WHERE
+    (
+        (base_currency = $1 AND quote_currency = $2)
+        OR (base_currency = $2 AND quote_currency = $1)
+    )
+    AND effective_on <= $3
+ORDER BY effective_on DESC, base_currency = $1 DESC
+LIMIT 1
+`
+
+type GetExchangeRateParams struct {
+	FromCurrency string      `db:"from_currency" json:"from_currency"`
+	ToCurrency   string      `db:"to_currency" json:"to_currency"`
+	EffectiveOn  pgtype.Date `db:"effective_on" json:"effective_on"`
+}
+
+func (q *Queries) GetExchangeRate(ctx context.Context, arg GetExchangeRateParams) (ExchangeRate, error) {
+	row := q.db.QueryRow(ctx, getExchangeRate, arg.FromCurrency, arg.ToCurrency, arg.EffectiveOn)
+	var i ExchangeRate
+	err := row.Scan(
+		&i.BaseCurrency,
+		&i.QuoteCurrency,
+		&i.EffectiveOn,
+		&i.Rate,
+	)
+	return i, err
+}
+
+const getExpense = `-- name: GetExpense :one
+SELECT
+    "id", "trip_id", "activity_id", "payer_email", "description", "amount", "currency", "split_kind", "created_at", "updated_at",
+    "category", "base_currency", "exchange_rate", "base_amount"
+FROM expenses
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type GetExpenseParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) GetExpense(ctx context.Context, arg GetExpenseParams) (Expense, error) {
+	row := q.db.QueryRow(ctx, getExpense, arg.ID, arg.TripID)
+	var i Expense
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.ActivityID,
+		&i.PayerEmail,
+		&i.Description,
+		&i.Amount,
+		&i.Currency,
+		&i.SplitKind,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Category,
+		&i.BaseCurrency,
+		&i.ExchangeRate,
+		&i.BaseAmount,
+	)
+	return i, err
+}
+
+const getExpenseSplits = `-- name: GetExpenseSplits :many
+SELECT
+    "id", "expense_id", "email", "position", "shares", "amount"
+FROM expense_splits
+WHERE
+    expense_id = $1
+ORDER BY position
+`
+
+func (q *Queries) GetExpenseSplits(ctx context.Context, expenseID uuid.UUID) ([]ExpenseSplit, error) {
+	rows, err := q.db.Query(ctx, getExpenseSplits, expenseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExpenseSplit
+	for rows.Next() {
+		var i ExpenseSplit
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExpenseID,
+			&i.Email,
+			&i.Position,
+			&i.Shares,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLinksOfTrips = `-- name: GetLinksOfTrips :many
+SELECT
+    "id", "trip_id", "title", "url", "preview_title", "preview_description", "preview_image_url", "favicon_url", "unfurled_at", "unfurl_locked_until"
+FROM links
+WHERE
+    trip_id = ANY($1::uuid[])
+ORDER BY "trip_id" ASC, "id" ASC
+`
+
+func (q *Queries) GetLinksOfTrips(ctx context.Context, tripIds []uuid.UUID) ([]Link, error) {
+	rows, err := q.db.Query(ctx, getLinksOfTrips, tripIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+			&i.PreviewTitle,
+			&i.PreviewDescription,
+			&i.PreviewImageUrl,
+			&i.FaviconUrl,
+			&i.UnfurledAt,
+			&i.UnfurlLockedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMemberTrips = `-- name: GetMemberTrips :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "status", "time_zone", "base_currency"
+FROM trips
+WHERE
+    LOWER(owner_email) = LOWER($1)
+    OR EXISTS (
+        SELECT 1 FROM participants
+        WHERE
+            participants.trip_id = trips.id
+            AND LOWER(participants.email) = LOWER($1)
+            AND participants.status <> 'declined'
+    )
+ORDER BY "starts_at" ASC, "id" ASC
+`
+
+func (q *Queries) GetMemberTrips(ctx context.Context, email string) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, getMemberTrips, email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Status,
+			&i.TimeZone,
+			&i.BaseCurrency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOpenChecklistItemsAssignedTo = `-- name: GetOpenChecklistItemsAssignedTo :many
+SELECT
+    checklists.title AS checklist_title, checklist_items.title, checklist_items.group_name
+FROM checklist_items
+JOIN checklists ON checklists.id = checklist_items.checklist_id
+WHERE
+    checklists.trip_id = $1
+    AND LOWER(checklist_items.assignee_email) = LOWER($2)
+    AND NOT checklist_items.is_done
+ORDER BY checklists.created_at, checklists.id, checklist_items.position, checklist_items.created_at
+`
+
+type GetOpenChecklistItemsAssignedToParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Email  string    `db:"email" json:"email"`
+}
+
+type GetOpenChecklistItemsAssignedToRow struct {
+	ChecklistTitle string      `db:"checklist_title" json:"checklist_title"`
+	Title          string      `db:"title" json:"title"`
+	GroupName      pgtype.Text `db:"group_name" json:"group_name"`
+}
+
+func (q *Queries) GetOpenChecklistItemsAssignedTo(ctx context.Context, arg GetOpenChecklistItemsAssignedToParams) ([]GetOpenChecklistItemsAssignedToRow, error) {
+	rows, err := q.db.Query(ctx, getOpenChecklistItemsAssignedTo, arg.TripID, arg.Email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOpenChecklistItemsAssignedToRow
+	for rows.Next() {
+		var i GetOpenChecklistItemsAssignedToRow
+		if err := rows.Scan(
+			&i.ChecklistTitle,
+			&i.Title,
+			&i.GroupName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipant = `-- name: GetParticipant :one
+SELECT
+    "id", "trip_id", "email", "status", "invited_at", "confirmed_at", "declined_at", "maybe_at", "name", "phone"
+FROM participants
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
+	row := q.db.QueryRow(ctx, getParticipant, id)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Email,
+		&i.Status,
+		&i.InvitedAt,
+		&i.ConfirmedAt,
+		&i.DeclinedAt,
+		&i.MaybeAt,
+		&i.Name,
+		&i.Phone,
+	)
+	return i, err
+}
+
+const getParticipants = `-- name: GetParticipants :many
+SELECT
+    "id", "trip_id", "email", "status", "invited_at", "confirmed_at", "declined_at", "maybe_at", "name", "phone"
+FROM participants
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getParticipants, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.Status,
+			&i.InvitedAt,
+			&i.ConfirmedAt,
+			&i.DeclinedAt,
+			&i.MaybeAt,
+			&i.Name,
+			&i.Phone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrip = `-- name: GetTrip :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "status", "time_zone", "base_currency"
+FROM trips
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getTrip, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Status,
+		&i.TimeZone,
+		&i.BaseCurrency,
+	)
+	return i, err
+}
+
+const getTripActivities = `-- name: GetTripActivities :many
+SELECT
+    "id", "trip_id", "title", "occurs_at"
+FROM activities
+WHERE
+    trip_id = $1
+ORDER BY "occurs_at" ASC
+`
+
+func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivities, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivityProposalOptions = `-- name: GetTripActivityProposalOptions :many
+SELECT
+    activity_proposal_options.id, activity_proposal_options.proposal_id, activity_proposal_options.label,
+    activity_proposal_options.occurs_at, activity_proposal_options.position
+FROM activity_proposal_options
+JOIN activity_proposals ON activity_proposals.id = activity_proposal_options.proposal_id
+WHERE
+    activity_proposals.trip_id = $1
+ORDER BY activity_proposal_options.proposal_id, activity_proposal_options.position
+`
+
+func (q *Queries) GetTripActivityProposalOptions(ctx context.Context, tripID uuid.UUID) ([]ActivityProposalOption, error) {
+	rows, err := q.db.Query(ctx, getTripActivityProposalOptions, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActivityProposalOption
+	for rows.Next() {
+		var i ActivityProposalOption
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProposalID,
+			&i.Label,
+			&i.OccursAt,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivityProposalVotes = `-- name: GetTripActivityProposalVotes :many
+SELECT
+    activity_proposal_votes.id, activity_proposal_votes.proposal_id, activity_proposal_votes.email,
+    activity_proposal_votes.value, activity_proposal_votes.option_id, activity_proposal_votes.voted_at
+FROM activity_proposal_votes
+JOIN activity_proposals ON activity_proposals.id = activity_proposal_votes.proposal_id
+JOIN trips ON trips.id = activity_proposals.trip_id
+WHERE
+    activity_proposals.trip_id = $1
+    -- votes of participants who declined or were removed after voting are kept, but only count while they are confirmed
+    AND (
+        LOWER(activity_proposal_votes.email) = LOWER(trips.owner_email)
+        OR EXISTS (
+            SELECT 1
+            FROM participants
+            WHERE
+                participants.trip_id = trips.id
+                AND LOWER(participants.email) = LOWER(activity_proposal_votes.email)
+                AND participants.status = 'confirmed'
+        )
+    )
+ORDER BY activity_proposal_votes.proposal_id, activity_proposal_votes.voted_at
+`
+
+func (q *Queries) GetTripActivityProposalVotes(ctx context.Context, tripID uuid.UUID) ([]ActivityProposalVote, error) {
+	rows, err := q.db.Query(ctx, getTripActivityProposalVotes, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActivityProposalVote
+	for rows.Next() {
+		var i ActivityProposalVote
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProposalID,
+			&i.Email,
+			&i.Value,
+			&i.OptionID,
+			&i.VotedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivityProposals = `-- name: GetTripActivityProposals :many
+SELECT
+    "id", "trip_id", "title", "occurs_at", "proposed_by", "activity_id", "promoted_at", "created_at"
+FROM activity_proposals
+WHERE
+    trip_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) GetTripActivityProposals(ctx context.Context, tripID uuid.UUID) ([]ActivityProposal, error) {
+	rows, err := q.db.Query(ctx, getTripActivityProposals, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActivityProposal
+	for rows.Next() {
+		var i ActivityProposal
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.ProposedBy,
+			&i.ActivityID,
+			&i.PromotedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripBudgets = `-- name: GetTripBudgets :many
+SELECT
+    "id", "trip_id", "category", "amount", "currency", "base_currency", "exchange_rate", "base_amount"
+FROM trip_budgets
+WHERE
+    trip_id = $1
+ORDER BY category
+`
+
+func (q *Queries) GetTripBudgets(ctx context.Context, tripID uuid.UUID) ([]TripBudget, error) {
+	rows, err := q.db.Query(ctx, getTripBudgets, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TripBudget
+	for rows.Next() {
+		var i TripBudget
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Category,
+			&i.Amount,
+			&i.Currency,
+			&i.BaseCurrency,
+			&i.ExchangeRate,
+			&i.BaseAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripChecklistItems = `-- name: GetTripChecklistItems :many
+SELECT
+    checklist_items.id, checklist_items.checklist_id, checklist_items.title, checklist_items.group_name,
+    checklist_items.assignee_email, checklist_items.is_done, checklist_items.done_at, checklist_items.position,
+    checklist_items.created_at
+FROM checklist_items
+JOIN checklists ON checklists.id = checklist_items.checklist_id
+WHERE
+    checklists.trip_id = $1
+ORDER BY checklist_items.checklist_id, checklist_items.position, checklist_items.created_at, checklist_items.id
+`
+
+func (q *Queries) GetTripChecklistItems(ctx context.Context, tripID uuid.UUID) ([]ChecklistItem, error) {
+	rows, err := q.db.Query(ctx, getTripChecklistItems, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChecklistItem
+	for rows.Next() {
+		var i ChecklistItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChecklistID,
+			&i.Title,
+			&i.GroupName,
+			&i.AssigneeEmail,
+			&i.IsDone,
+			&i.DoneAt,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripChecklists = `-- name: GetTripChecklists :many
+SELECT
+    "id", "trip_id", "title", "kind", "created_at"
+FROM checklists
+WHERE
+    trip_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) GetTripChecklists(ctx context.Context, tripID uuid.UUID) ([]Checklist, error) {
+	rows, err := q.db.Query(ctx, getTripChecklists, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Checklist
+	for rows.Next() {
+		var i Checklist
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Kind,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripExpenseSplits = `-- name: GetTripExpenseSplits :many
+SELECT
+    expense_splits.id, expense_splits.expense_id, expense_splits.email, expense_splits.position, expense_splits.shares, expense_splits.amount
+FROM expense_splits
+JOIN expenses ON expenses.id = expense_splits.expense_id
+WHERE
+    expenses.trip_id = $1
+ORDER BY expense_splits.expense_id, expense_splits.position
+`
+
+func (q *Queries) GetTripExpenseSplits(ctx context.Context, tripID uuid.UUID) ([]ExpenseSplit, error) {
+	rows, err := q.db.Query(ctx, getTripExpenseSplits, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExpenseSplit
+	for rows.Next() {
+		var i ExpenseSplit
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExpenseID,
+			&i.Email,
+			&i.Position,
+			&i.Shares,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripExpenses = `-- name: GetTripExpenses :many
+SELECT
+    "id", "trip_id", "activity_id", "payer_email", "description", "amount", "currency", "split_kind", "created_at", "updated_at",
+    "category", "base_currency", "exchange_rate", "base_amount"
+FROM expenses
+WHERE
+    trip_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) GetTripExpenses(ctx context.Context, tripID uuid.UUID) ([]Expense, error) {
+	rows, err := q.db.Query(ctx, getTripExpenses, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Expense
+	for rows.Next() {
+		var i Expense
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.ActivityID,
+			&i.PayerEmail,
+			&i.Description,
+			&i.Amount,
+			&i.Currency,
+			&i.SplitKind,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Category,
+			&i.BaseCurrency,
+			&i.ExchangeRate,
+			&i.BaseAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripLinks = `-- name: GetTripLinks :many
+SELECT
+    "id", "trip_id", "title", "url", "preview_title", "preview_description", "preview_image_url", "favicon_url", "unfurled_at", "unfurl_locked_until"
+FROM links
+WHERE
+    trip_id = $1
+ORDER BY "id"
+`
+
+func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
+	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+			&i.PreviewTitle,
+			&i.PreviewDescription,
+			&i.PreviewImageUrl,
+			&i.FaviconUrl,
+			&i.UnfurledAt,
+			&i.UnfurlLockedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripSettlements = `-- name: GetTripSettlements :many
+SELECT
+    "id", "trip_id", "from_email", "to_email", "amount", "currency", "created_at"
+FROM settlements
+WHERE
+    trip_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) GetTripSettlements(ctx context.Context, tripID uuid.UUID) ([]Settlement, error) {
+	rows, err := q.db.Query(ctx, getTripSettlements, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Settlement
+	for rows.Next() {
+		var i Settlement
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.FromEmail,
+			&i.ToEmail,
+			&i.Amount,
+			&i.Currency,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTemplate = `-- name: GetTripTemplate :one
+SELECT
+    "id", "owner_email", "name", "created_at", "updated_at"
+FROM trip_templates
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetTripTemplate(ctx context.Context, id uuid.UUID) (TripTemplate, error) {
+	row := q.db.QueryRow(ctx, getTripTemplate, id)
+	var i TripTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerEmail,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTripTemplateActivities = `-- name: GetTripTemplateActivities :many
+SELECT
+    "id", "template_id", "title", "day", "time"
+FROM trip_template_activities
+WHERE
+    template_id = $1
+ORDER BY "day" ASC, "time" ASC
+`
+
+func (q *Queries) GetTripTemplateActivities(ctx context.Context, templateID uuid.UUID) ([]TripTemplateActivity, error) {
+	rows, err := q.db.Query(ctx, getTripTemplateActivities, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TripTemplateActivity
+	for rows.Next() {
+		var i TripTemplateActivity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TemplateID,
+			&i.Title,
+			&i.Day,
+			&i.Time,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTemplateLinks = `-- name: GetTripTemplateLinks :many
+SELECT
+    "id", "template_id", "title", "url"
+FROM trip_template_links
+WHERE
+    template_id = $1
+`
+
+func (q *Queries) GetTripTemplateLinks(ctx context.Context, templateID uuid.UUID) ([]TripTemplateLink, error) {
+	rows, err := q.db.Query(ctx, getTripTemplateLinks, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TripTemplateLink
+	for rows.Next() {
+		var i TripTemplateLink
+		if err := rows.Scan(
+			&i.ID,
+			&i.TemplateID,
+			&i.Title,
+			&i.Url,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertActivityProposal = `-- name: InsertActivityProposal :one
+INSERT INTO activity_proposals
+    ("trip_id", "title", "occurs_at", "proposed_by") VALUES
+    ($1, $2, $3, $4)
+RETURNING "id"
+`
+
+type InsertActivityProposalParams struct {
+	TripID     uuid.UUID          `db:"trip_id" json:"trip_id"`
+	Title      string             `db:"title" json:"title"`
+	OccursAt   pgtype.Timestamptz `db:"occurs_at" json:"occurs_at"`
+	ProposedBy string             `db:"proposed_by" json:"proposed_by"`
+}
+
+func (q *Queries) InsertActivityProposal(ctx context.Context, arg InsertActivityProposalParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertActivityProposal,
+		arg.TripID,
+		arg.Title,
+		arg.OccursAt,
+		arg.ProposedBy,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertActivityProposalOption = `-- name: InsertActivityProposalOption :exec
+INSERT INTO activity_proposal_options
+    ("proposal_id", "label", "occurs_at", "position") VALUES
+    ($1, $2, $3, $4)
+`
+
+type InsertActivityProposalOptionParams struct {
+	ProposalID uuid.UUID          `db:"proposal_id" json:"proposal_id"`
+	Label      string             `db:"label" json:"label"`
+	OccursAt   pgtype.Timestamptz `db:"occurs_at" json:"occurs_at"`
+	Position   int32              `db:"position" json:"position"`
+}
+
+func (q *Queries) InsertActivityProposalOption(ctx context.Context, arg InsertActivityProposalOptionParams) error {
+	_, err := q.db.Exec(ctx, insertActivityProposalOption,
+		arg.ProposalID,
+		arg.Label,
+		arg.OccursAt,
+		arg.Position,
+	)
+	return err
+}
+
+const insertChecklist = `-- name: InsertChecklist :one
+INSERT INTO checklists
+    ("trip_id", "title", "kind") VALUES
+    ($1, $2, $3)
+RETURNING "id"
+`
+
+type InsertChecklistParams struct {
+	TripID uuid.UUID     `db:"trip_id" json:"trip_id"`
+	Title  string        `db:"title" json:"title"`
+	Kind   ChecklistKind `db:"kind" json:"kind"`
+}
+
+func (q *Queries) InsertChecklist(ctx context.Context, arg InsertChecklistParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertChecklist, arg.TripID, arg.Title, arg.Kind)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertChecklistItem = `-- name: InsertChecklistItem :one
+INSERT INTO checklist_items
+    ("checklist_id", "title", "group_name", "assignee_email", "is_done", "done_at", "position") VALUES
+    ($1, $2, $3, $4, $5, CASE WHEN $5 THEN NOW() END, (
+        SELECT COALESCE(MAX(position) + 1, 0) FROM checklist_items WHERE checklist_id = $1
+    ))
+RETURNING "id"
+`
+
+type InsertChecklistItemParams struct {
+	ChecklistID   uuid.UUID   `db:"checklist_id" json:"checklist_id"`
+	Title         string      `db:"title" json:"title"`
+	GroupName     pgtype.Text `db:"group_name" json:"group_name"`
+	AssigneeEmail pgtype.Text `db:"assignee_email" json:"assignee_email"`
+	IsDone        bool        `db:"is_done" json:"is_done"`
+}
+
+func (q *Queries) InsertChecklistItem(ctx context.Context, arg InsertChecklistItemParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertChecklistItem,
+		arg.ChecklistID,
+		arg.Title,
+		arg.GroupName,
+		arg.AssigneeEmail,
+		arg.IsDone,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertExpense = `-- name: InsertExpense :one
+INSERT INTO expenses
+    ("trip_id", "activity_id", "payer_email", "description", "amount", "currency", "split_kind", "category",
+     "base_currency", "exchange_rate", "base_amount") VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING "id"
+`
+
+type InsertExpenseParams struct {
+	TripID       uuid.UUID        `db:"trip_id" json:"trip_id"`
+	ActivityID   pgtype.UUID      `db:"activity_id" json:"activity_id"`
+	PayerEmail   string           `db:"payer_email" json:"payer_email"`
+	Description  string           `db:"description" json:"description"`
+	Amount       int64            `db:"amount" json:"amount"`
+	Currency     string           `db:"currency" json:"currency"`
+	SplitKind    ExpenseSplitKind `db:"split_kind" json:"split_kind"`
+	Category     string           `db:"category" json:"category"`
+	BaseCurrency pgtype.Text      `db:"base_currency" json:"base_currency"`
+	ExchangeRate pgtype.Numeric   `db:"exchange_rate" json:"exchange_rate"`
+	BaseAmount   pgtype.Int8      `db:"base_amount" json:"base_amount"`
+}
+
+func (q *Queries) InsertExpense(ctx context.Context, arg InsertExpenseParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertExpense,
+		arg.TripID,
+		arg.ActivityID,
+		arg.PayerEmail,
+		arg.Description,
+		arg.Amount,
+		arg.Currency,
+		arg.SplitKind,
+		arg.Category,
+		arg.BaseCurrency,
+		arg.ExchangeRate,
+		arg.BaseAmount,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertExpenseSplit = `-- name: InsertExpenseSplit :exec
+INSERT INTO expense_splits
+    ("expense_id", "email", "position", "shares", "amount") VALUES
+    ($1, $2, $3, $4, $5)
+`
+
+type InsertExpenseSplitParams struct {
+	ExpenseID uuid.UUID   `db:"expense_id" json:"expense_id"`
+	Email     string      `db:"email" json:"email"`
+	Position  int32       `db:"position" json:"position"`
+	Shares    pgtype.Int4 `db:"shares" json:"shares"`
+	Amount    int64       `db:"amount" json:"amount"`
+}
+
+func (q *Queries) InsertExpenseSplit(ctx context.Context, arg InsertExpenseSplitParams) error {
+	_, err := q.db.Exec(ctx, insertExpenseSplit,
+		arg.ExpenseID,
+		arg.Email,
+		arg.Position,
+		arg.Shares,
+		arg.Amount,
+	)
+	return err
+}
+
+const insertImportedTrip = `-- name: InsertImportedTrip :one
+INSERT INTO trips
+    ("destination", "owner_email", "owner_name", "starts_at", "ends_at", "time_zone", "status", "base_currency") VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING "id"
+`
+
+type InsertImportedTripParams struct {
+	Destination  string             `db:"destination" json:"destination"`
+	OwnerEmail   string             `db:"owner_email" json:"owner_email"`
+	OwnerName    string             `db:"owner_name" json:"owner_name"`
+	StartsAt     pgtype.Timestamptz `db:"starts_at" json:"starts_at"`
+	EndsAt       pgtype.Timestamptz `db:"ends_at" json:"ends_at"`
+	TimeZone     string             `db:"time_zone" json:"time_zone"`
+	Status       TripStatus         `db:"status" json:"status"`
+	BaseCurrency string             `db:"base_currency" json:"base_currency"`
+}
+
+func (q *Queries) InsertImportedTrip(ctx context.Context, arg InsertImportedTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertImportedTrip,
+		arg.Destination,
+		arg.OwnerEmail,
+		arg.OwnerName,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.TimeZone,
+		arg.Status,
+		arg.BaseCurrency,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertSettlement = `-- name: InsertSettlement :one
+INSERT INTO settlements
+    ("trip_id", "from_email", "to_email", "amount", "currency") VALUES
+    ($1, $2, $3, $4, $5)
+RETURNING "id"
+`
+
+type InsertSettlementParams struct {
+	TripID    uuid.UUID `db:"trip_id" json:"trip_id"`
+	FromEmail string    `db:"from_email" json:"from_email"`
+	ToEmail   string    `db:"to_email" json:"to_email"`
+	Amount    int64     `db:"amount" json:"amount"`
+	Currency  string    `db:"currency" json:"currency"`
+}
+
+func (q *Queries) InsertSettlement(ctx context.Context, arg InsertSettlementParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertSettlement,
+		arg.TripID,
+		arg.FromEmail,
+		arg.ToEmail,
+		arg.Amount,
+		arg.Currency,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertTrip = `-- name: InsertTrip :one
+INSERT INTO trips
+    ("destination", "owner_email", "owner_name", "starts_at", "ends_at", "time_zone", "base_currency") VALUES
+    ($1, $2, $3, $4, $5, $6, $7)
+RETURNING "id"
+`
+
+type InsertTripParams struct {
+	Destination  string             `db:"destination" json:"destination"`
+	OwnerEmail   string             `db:"owner_email" json:"owner_email"`
+	OwnerName    string             `db:"owner_name" json:"owner_name"`
+	StartsAt     pgtype.Timestamptz `db:"starts_at" json:"starts_at"`
+	EndsAt       pgtype.Timestamptz `db:"ends_at" json:"ends_at"`
+	TimeZone     string             `db:"time_zone" json:"time_zone"`
+	BaseCurrency string             `db:"base_currency" json:"base_currency"`
+}
+
+func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertTrip,
+		arg.Destination,
+		arg.OwnerEmail,
+		arg.OwnerName,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.TimeZone,
+		arg.BaseCurrency,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertTripBudget = `-- name: InsertTripBudget :exec
+INSERT INTO trip_budgets
+    ("trip_id", "category", "amount", "currency", "base_currency", "exchange_rate", "base_amount") VALUES
+    ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type InsertTripBudgetParams struct {
+	TripID       uuid.UUID      `db:"trip_id" json:"trip_id"`
+	Category     string         `db:"category" json:"category"`
+	Amount       int64          `db:"amount" json:"amount"`
+	Currency     string         `db:"currency" json:"currency"`
+	BaseCurrency string         `db:"base_currency" json:"base_currency"`
+	ExchangeRate pgtype.Numeric `db:"exchange_rate" json:"exchange_rate"`
+	BaseAmount   int64          `db:"base_amount" json:"base_amount"`
+}
+
+func (q *Queries) InsertTripBudget(ctx context.Context, arg InsertTripBudgetParams) error {
+	_, err := q.db.Exec(ctx, insertTripBudget,
+		arg.TripID,
+		arg.Category,
+		arg.Amount,
+		arg.Currency,
+		arg.BaseCurrency,
+		arg.ExchangeRate,
+		arg.BaseAmount,
+	)
+	return err
+}
+
+const insertTripTemplate = `-- name: InsertTripTemplate :one
+INSERT INTO trip_templates
+    ("owner_email", "name") VALUES
+    ($1, $2)
+RETURNING "id"
+`
+
+type InsertTripTemplateParams struct {
+	OwnerEmail string `db:"owner_email" json:"owner_email"`
+	Name       string `db:"name" json:"name"`
+}
+
+func (q *Queries) InsertTripTemplate(ctx context.Context, arg InsertTripTemplateParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertTripTemplate, arg.OwnerEmail, arg.Name)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertTripTemplateActivity = `-- name: InsertTripTemplateActivity :exec
+INSERT INTO trip_template_activities
+    ("template_id", "title", "day", "time") VALUES
+    ($1, $2, $3, $4)
+`
+
+type InsertTripTemplateActivityParams struct {
+	TemplateID uuid.UUID   `db:"template_id" json:"template_id"`
+	Title      string      `db:"title" json:"title"`
+	Day        int32       `db:"day" json:"day"`
+	Time       pgtype.Time `db:"time" json:"time"`
+}
+
+func (q *Queries) InsertTripTemplateActivity(ctx context.Context, arg InsertTripTemplateActivityParams) error {
+	_, err := q.db.Exec(ctx, insertTripTemplateActivity,
+		arg.TemplateID,
+		arg.Title,
+		arg.Day,
+		arg.Time,
+	)
+	return err
+}
+
+const insertTripTemplateLink = `-- name: InsertTripTemplateLink :exec
+INSERT INTO trip_template_links
+    ("template_id", "title", "url") VALUES
+    ($1, $2, $3)
+`
+
+type InsertTripTemplateLinkParams struct {
+	TemplateID uuid.UUID `db:"template_id" json:"template_id"`
+	Title      string    `db:"title" json:"title"`
+	Url        string    `db:"url" json:"url"`
+}
+
+func (q *Queries) InsertTripTemplateLink(ctx context.Context, arg InsertTripTemplateLinkParams) error {
+	_, err := q.db.Exec(ctx, insertTripTemplateLink, arg.TemplateID, arg.Title, arg.Url)
+	return err
+}
+
+const inviteParticipantToTrip = `-- name: InviteParticipantToTrip :one
+INSERT INTO participants
+( "trip_id", "email" ) VALUES
+    ( $1, $2 )
+RETURNING "id"
+`
+
+type InviteParticipantToTripParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Email  string    `db:"email" json:"email"`
+}
+
+func (q *Queries) InviteParticipantToTrip(ctx context.Context, arg InviteParticipantToTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, inviteParticipantToTrip, arg.TripID, arg.Email)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+type InviteParticipantsToTripParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Email  string    `db:"email" json:"email"`
+}
+
+const isConfirmedParticipant = `-- name: IsConfirmedParticipant :one
+SELECT EXISTS (
+    SELECT 1
+    FROM participants
+    WHERE
+        trip_id = $1
+        AND LOWER(email) = LOWER($2)
+        AND status = 'confirmed'
+)
+`
+
+type IsConfirmedParticipantParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Email  string    `db:"email" json:"email"`
+}
+
+func (q *Queries) IsConfirmedParticipant(ctx context.Context, arg IsConfirmedParticipantParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isConfirmedParticipant, arg.TripID, arg.Email)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isKnownEmail = `-- name: IsKnownEmail :one
+SELECT EXISTS (
+    SELECT 1 FROM trips WHERE LOWER(owner_email) = LOWER($1)
+    UNION ALL
+    SELECT 1 FROM participants WHERE LOWER(email) = LOWER($1)
+)
+`
+
+func (q *Queries) IsKnownEmail(ctx context.Context, email string) (bool, error) {
+	row := q.db.QueryRow(ctx, isKnownEmail, email)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isTokenUsed = `-- name: IsTokenUsed :one
+SELECT EXISTS (
+    SELECT 1 FROM used_tokens WHERE id = $1
+)
+`
+
+func (q *Queries) IsTokenUsed(ctx context.Context, id uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, isTokenUsed, id)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isTripActivity = `-- name: IsTripActivity :one
+SELECT EXISTS (
+    SELECT 1
+    FROM activities
+    WHERE
+        id = $1
+        AND trip_id = $2
+)
+`
+
+type IsTripActivityParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) IsTripActivity(ctx context.Context, arg IsTripActivityParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isTripActivity, arg.ID, arg.TripID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isTripChecklist = `-- name: IsTripChecklist :one
+SELECT EXISTS (
+    SELECT 1
+    FROM checklists
+    WHERE
+        id = $1
+        AND trip_id = $2
+)
+`
+
+type IsTripChecklistParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) IsTripChecklist(ctx context.Context, arg IsTripChecklistParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isTripChecklist, arg.ID, arg.TripID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isTripMember = `-- name: IsTripMember :one
+SELECT EXISTS (
+    SELECT 1
+    FROM participants
+    WHERE
+        trip_id = $1
+        AND LOWER(email) = LOWER($2)
+)
+`
+
+type IsTripMemberParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Email  string    `db:"email" json:"email"`
+}
+
+func (q *Queries) IsTripMember(ctx context.Context, arg IsTripMemberParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isTripMember, arg.TripID, arg.Email)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listTripTemplates = `-- name: ListTripTemplates :many
+SELECT
+    "id", "owner_email", "name", "created_at", "updated_at"
+FROM trip_templates
+WHERE
+    LOWER(owner_email) = LOWER($1)
+ORDER BY "name" ASC, "id" ASC
+`
+
+func (q *Queries) ListTripTemplates(ctx context.Context, ownerEmail string) ([]TripTemplate, error) {
+	rows, err := q.db.Query(ctx, listTripTemplates, ownerEmail)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TripTemplate
+	for rows.Next() {
+		var i TripTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerEmail,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrips = `-- name: ListTrips :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "status", "time_zone", "base_currency"
+FROM trips
+WHERE
+    (
+        $1::text IS NULL
+        OR LOWER(owner_email) = LOWER($1)
+        OR EXISTS (
+            SELECT 1 FROM participants
+            WHERE participants.trip_id = trips.id AND LOWER(participants.email) = LOWER($1)
+        )
+    )
+    AND ($2::text IS NULL OR LOWER(owner_email) = LOWER($2))
+    AND (
+        $3::text IS NULL
+        OR EXISTS (
+            SELECT 1 FROM participants
+            WHERE participants.trip_id = trips.id AND LOWER(participants.email) = LOWER($3)
+        )
+    )
+    -- confirmed trips that have already ended are filtered as completed, which is how currentTripStatus reports them
+    AND (
+        $4::trip_status IS NULL
+        OR (CASE WHEN "status" = 'confirmed' AND "ends_at" < NOW() THEN 'completed'::trip_status ELSE "status" END) = $4
+    )
+    AND ($5::timestamptz IS NULL OR "starts_at" >= $5)
+    AND ($6::timestamptz IS NULL OR "ends_at" <= $6)
+    AND (
+        $7::timestamptz IS NULL
+        OR ("starts_at", "id") > ($7, $8::uuid)
+    )
+ORDER BY "starts_at" ASC, "id" ASC
+LIMIT $9
+`
+
+type ListTripsParams struct {
+	MemberEmail      pgtype.Text        `db:"member_email" json:"member_email"`
+	OwnerEmail       pgtype.Text        `db:"owner_email" json:"owner_email"`
+	ParticipantEmail pgtype.Text        `db:"participant_email" json:"participant_email"`
+	Status           NullTripStatus     `db:"status" json:"status"`
+	StartsAfter      pgtype.Timestamptz `db:"starts_after" json:"starts_after"`
+	EndsBefore       pgtype.Timestamptz `db:"ends_before" json:"ends_before"`
+	CursorStartsAt   pgtype.Timestamptz `db:"cursor_starts_at" json:"cursor_starts_at"`
+	CursorID         pgtype.UUID        `db:"cursor_id" json:"cursor_id"`
+	PageSize         int32              `db:"page_size" json:"page_size"`
+}
+
+func (q *Queries) ListTrips(ctx context.Context, arg ListTripsParams) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, listTrips,
+		arg.MemberEmail,
+		arg.OwnerEmail,
+		arg.ParticipantEmail,
+		arg.Status,
+		arg.StartsAfter,
+		arg.EndsBefore,
+		arg.CursorStartsAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Status,
+			&i.TimeZone,
+			&i.BaseCurrency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTripsDesc = `-- name: ListTripsDesc :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "status", "time_zone", "base_currency"
+FROM trips
+WHERE
+    (
+        $1::text IS NULL
+        OR LOWER(owner_email) = LOWER($1)
+        OR EXISTS (
+            SELECT 1 FROM participants
+            WHERE participants.trip_id = trips.id AND LOWER(participants.email) = LOWER($1)
+        )
+    )
+    AND ($2::text IS NULL OR LOWER(owner_email) = LOWER($2))
+    AND (
+        $3::text IS NULL
+        OR EXISTS (
+            SELECT 1 FROM participants
+            WHERE participants.trip_id = trips.id AND LOWER(participants.email) = LOWER($3)
+        )
+    )
+    -- completed trips are filtered the same way as in ListTrips
+    AND (
+        $4::trip_status IS NULL
+        OR (CASE WHEN "status" = 'confirmed' AND "ends_at" < NOW() THEN 'completed'::trip_status ELSE "status" END) = $4
+    )
+    AND ($5::timestamptz IS NULL OR "starts_at" >= $5)
+    AND ($6::timestamptz IS NULL OR "ends_at" <= $6)
+    AND (
+        $7::timestamptz IS NULL
+        OR ("starts_at", "id") < ($7, $8::uuid)
+    )
+ORDER BY "starts_at" DESC, "id" DESC
+LIMIT $9
+`
+
+type ListTripsDescParams struct {
+	MemberEmail      pgtype.Text        `db:"member_email" json:"member_email"`
+	OwnerEmail       pgtype.Text        `db:"owner_email" json:"owner_email"`
+	ParticipantEmail pgtype.Text        `db:"participant_email" json:"participant_email"`
+	Status           NullTripStatus     `db:"status" json:"status"`
+	StartsAfter      pgtype.Timestamptz `db:"starts_after" json:"starts_after"`
+	EndsBefore       pgtype.Timestamptz `db:"ends_before" json:"ends_before"`
+	CursorStartsAt   pgtype.Timestamptz `db:"cursor_starts_at" json:"cursor_starts_at"`
+	CursorID         pgtype.UUID        `db:"cursor_id" json:"cursor_id"`
+	PageSize         int32              `db:"page_size" json:"page_size"`
+}
+
+func (q *Queries) ListTripsDesc(ctx context.Context, arg ListTripsDescParams) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, listTripsDesc,
+		arg.MemberEmail,
+		arg.OwnerEmail,
+		arg.ParticipantEmail,
+		arg.Status,
+		arg.StartsAfter,
+		arg.EndsBefore,
+		arg.CursorStartsAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Status,
+			&i.TimeZone,
+			&i.BaseCurrency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markActivityProposalAsPromoted = `-- name: MarkActivityProposalAsPromoted :execrows
+UPDATE activity_proposals
+SET
+    "activity_id" = $1,
+    "promoted_at" = NOW()
+WHERE
+    id = $2
+    AND promoted_at IS NULL
+`
+
+type MarkActivityProposalAsPromotedParams struct {
+	ActivityID pgtype.UUID `db:"activity_id" json:"activity_id"`
+	ID         uuid.UUID   `db:"id" json:"id"`
+}
+
+func (q *Queries) MarkActivityProposalAsPromoted(ctx context.Context, arg MarkActivityProposalAsPromotedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markActivityProposalAsPromoted, arg.ActivityID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markMailJobAsDead = `-- name: MarkMailJobAsDead :exec
+UPDATE mail_jobs
+SET
+    "status" = 'dead',
+    "last_error" = $1,
+    "locked_until" = NULL
+WHERE
+    id = $2
+`
+
+type MarkMailJobAsDeadParams struct {
+	LastError pgtype.Text `db:"last_error" json:"last_error"`
+	ID        uuid.UUID   `db:"id" json:"id"`
+}
+
+func (q *Queries) MarkMailJobAsDead(ctx context.Context, arg MarkMailJobAsDeadParams) error {
+	_, err := q.db.Exec(ctx, markMailJobAsDead, arg.LastError, arg.ID)
+	return err
+}
+
+const markMailJobAsSent = `-- name: MarkMailJobAsSent :exec
+UPDATE mail_jobs
+SET
+    "status" = 'sent',
+    "locked_until" = NULL
+WHERE
+    id = $1
+`
+
+func (q *Queries) MarkMailJobAsSent(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markMailJobAsSent, id)
+	return err
+}
+
+const requeueMailJob = `-- name: RequeueMailJob :execrows
+UPDATE mail_jobs
+SET
+    "status" = 'pending',
+    "attempts" = 0,
+    "run_at" = NOW(),
+    "locked_until" = NULL
+WHERE
+    id = $1
+    AND status = 'dead'
+`
+
+func (q *Queries) RequeueMailJob(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, requeueMailJob, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const retryMailJob = `-- name: RetryMailJob :exec
+UPDATE mail_jobs
+SET
+    "last_error" = $1,
+    "run_at" = NOW() + $2::interval,
+    "locked_until" = NULL
+WHERE
+    id = $3
+`
+
+type RetryMailJobParams struct {
+	LastError pgtype.Text     `db:"last_error" json:"last_error"`
+	Backoff   pgtype.Interval `db:"backoff" json:"backoff"`
+	ID        uuid.UUID       `db:"id" json:"id"`
+}
+
+func (q *Queries) RetryMailJob(ctx context.Context, arg RetryMailJobParams) error {
+	_, err := q.db.Exec(ctx, retryMailJob, arg.LastError, arg.Backoff, arg.ID)
+	return err
+}
+
+const revokeCalendarFeeds = `-- name: RevokeCalendarFeeds :execrows
+UPDATE calendar_feeds
+SET
+    "revoked_at" = NOW()
+WHERE
+    LOWER(email) = LOWER($1)
+    AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeCalendarFeeds(ctx context.Context, email string) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeCalendarFeeds, email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const saveLinkPreview = `-- name: SaveLinkPreview :exec
+UPDATE links
+SET
+    "preview_title" = $1,
+    "preview_description" = $2,
+    "preview_image_url" = $3,
+    "favicon_url" = $4,
+    "unfurled_at" = NOW(),
+    "unfurl_locked_until" = NULL
+WHERE
+    id = $5
+    AND url = $6
+`
+
+type SaveLinkPreviewParams struct {
+	PreviewTitle       pgtype.Text `db:"preview_title" json:"preview_title"`
+	PreviewDescription pgtype.Text `db:"preview_description" json:"preview_description"`
+	PreviewImageUrl    pgtype.Text `db:"preview_image_url" json:"preview_image_url"`
+	FaviconUrl         pgtype.Text `db:"favicon_url" json:"favicon_url"`
+	ID                 uuid.UUID   `db:"id" json:"id"`
+	Url                string      `db:"url" json:"url"`
+}
+
+func (q *Queries) SaveLinkPreview(ctx context.Context, arg SaveLinkPreviewParams) error {
+	_, err := q.db.Exec(ctx, saveLinkPreview,
+		arg.PreviewTitle,
+		arg.PreviewDescription,
+		arg.PreviewImageUrl,
+		arg.FaviconUrl,
+		arg.ID,
+		arg.Url,
+	)
+	return err
+}
+
+const setChecklistItemPosition = `-- name: SetChecklistItemPosition :exec
+UPDATE checklist_items
+SET
+    "position" = $1
+WHERE
+    id = $2
+    AND checklist_id = $3
+`
+
+type SetChecklistItemPositionParams struct {
+	Position    int32     `db:"position" json:"position"`
+	ID          uuid.UUID `db:"id" json:"id"`
+	ChecklistID uuid.UUID `db:"checklist_id" json:"checklist_id"`
+}
+
+func (q *Queries) SetChecklistItemPosition(ctx context.Context, arg SetChecklistItemPositionParams) error {
+	_, err := q.db.Exec(ctx, setChecklistItemPosition, arg.Position, arg.ID, arg.ChecklistID)
+	return err
+}
+
+const shiftTripActivities = `-- name: ShiftTripActivities :exec
+UPDATE activities
+SET
+    "occurs_at" = "occurs_at" + $1::interval
+WHERE
+    trip_id = $2
+`
+
+type ShiftTripActivitiesParams struct {
+	Shift  pgtype.Interval `db:"shift" json:"shift"`
+	TripID uuid.UUID       `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) ShiftTripActivities(ctx context.Context, arg ShiftTripActivitiesParams) error {
+	_, err := q.db.Exec(ctx, shiftTripActivities, arg.Shift, arg.TripID)
+	return err
+}
+
+const tripHasExpensesOrBudgets = `-- name: TripHasExpensesOrBudgets :one
+SELECT EXISTS (
+    SELECT 1 FROM expenses WHERE trip_id = $1
+    UNION ALL
+    SELECT 1 FROM trip_budgets WHERE trip_id = $1
+)
+`
+
+func (q *Queries) TripHasExpensesOrBudgets(ctx context.Context, tripID uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, tripHasExpensesOrBudgets, tripID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const updateActivity = `-- name: UpdateActivity :execrows
+UPDATE activities
+SET
+    "title" = COALESCE($1, "title"),
+    "occurs_at" = COALESCE($2, "occurs_at")
+WHERE
+    id = $3
+    AND trip_id = $4
+`
+
+type UpdateActivityParams struct {
+	Title    pgtype.Text        `db:"title" json:"title"`
+	OccursAt pgtype.Timestamptz `db:"occurs_at" json:"occurs_at"`
+	ID       uuid.UUID          `db:"id" json:"id"`
+	TripID   uuid.UUID          `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UpdateActivity(ctx context.Context, arg UpdateActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateActivity,
+		arg.Title,
+		arg.OccursAt,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateChecklist = `-- name: UpdateChecklist :execrows
+UPDATE checklists
+SET
+    "title" = $1,
+    "kind" = $2
+WHERE
+    id = $3
+    AND trip_id = $4
+`
+
+type UpdateChecklistParams struct {
+	Title  string        `db:"title" json:"title"`
+	Kind   ChecklistKind `db:"kind" json:"kind"`
+	ID     uuid.UUID     `db:"id" json:"id"`
+	TripID uuid.UUID     `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UpdateChecklist(ctx context.Context, arg UpdateChecklistParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateChecklist,
+		arg.Title,
+		arg.Kind,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateChecklistItem = `-- name: UpdateChecklistItem :execrows
+UPDATE checklist_items
+SET
+    "title" = $1,
+    "group_name" = $2,
+    "assignee_email" = $3,
+    "is_done" = $4,
+    "done_at" = CASE WHEN $4 THEN COALESCE(done_at, NOW()) END
+WHERE
+    id = $5
+    AND checklist_id = $6
+`
+
+type UpdateChecklistItemParams struct {
+	Title         string      `db:"title" json:"title"`
+	GroupName     pgtype.Text `db:"group_name" json:"group_name"`
+	AssigneeEmail pgtype.Text `db:"assignee_email" json:"assignee_email"`
+	IsDone        bool        `db:"is_done" json:"is_done"`
+	ID            uuid.UUID   `db:"id" json:"id"`
+	ChecklistID   uuid.UUID   `db:"checklist_id" json:"checklist_id"`
+}
+
+func (q *Queries) UpdateChecklistItem(ctx context.Context, arg UpdateChecklistItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateChecklistItem,
+		arg.Title,
+		arg.GroupName,
+		arg.AssigneeEmail,
+		arg.IsDone,
+		arg.ID,
+		arg.ChecklistID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateExpense = `-- name: UpdateExpense :execrows
+UPDATE expenses
+SET
+    "activity_id" = $1,
+    "payer_email" = $2,
+    "description" = $3,
+    "amount" = $4,
+    "currency" = $5,
+    "split_kind" = $6,
+    "category" = $7,
+    "base_currency" = $8,
+    "exchange_rate" = $9,
+    "base_amount" = $10,
+    "updated_at" = NOW()
+WHERE
+    id = $11
+    AND trip_id = $12
+`
+
+type UpdateExpenseParams struct {
+	ActivityID   pgtype.UUID      `db:"activity_id" json:"activity_id"`
+	PayerEmail   string           `db:"payer_email" json:"payer_email"`
+	Description  string           `db:"description" json:"description"`
+	Amount       int64            `db:"amount" json:"amount"`
+	Currency     string           `db:"currency" json:"currency"`
+	SplitKind    ExpenseSplitKind `db:"split_kind" json:"split_kind"`
+	Category     string           `db:"category" json:"category"`
+	BaseCurrency pgtype.Text      `db:"base_currency" json:"base_currency"`
+	ExchangeRate pgtype.Numeric   `db:"exchange_rate" json:"exchange_rate"`
+	BaseAmount   pgtype.Int8      `db:"base_amount" json:"base_amount"`
+	ID           uuid.UUID        `db:"id" json:"id"`
+	TripID       uuid.UUID        `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UpdateExpense(ctx context.Context, arg UpdateExpenseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateExpense,
+		arg.ActivityID,
+		arg.PayerEmail,
+		arg.Description,
+		arg.Amount,
+		arg.Currency,
+		arg.SplitKind,
+		arg.Category,
+		arg.BaseCurrency,
+		arg.ExchangeRate,
+		arg.BaseAmount,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateLink = `-- name: UpdateLink :execrows
+UPDATE links
+SET
+    "title" = COALESCE($1, "title"),
+    "url" = COALESCE($2, "url"),
+    -- a new url needs a new preview, which the unfurler picks up once unfurled_at is cleared
+    "preview_title" = CASE WHEN COALESCE($2, "url") = "url" THEN "preview_title" END,
+    "preview_description" = CASE WHEN COALESCE($2, "url") = "url" THEN "preview_description" END,
+    "preview_image_url" = CASE WHEN COALESCE($2, "url") = "url" THEN "preview_image_url" END,
+    "favicon_url" = CASE WHEN COALESCE($2, "url") = "url" THEN "favicon_url" END,
+    "unfurled_at" = CASE WHEN COALESCE($2, "url") = "url" THEN "unfurled_at" END
+WHERE
+    id = $3
+    AND trip_id = $4
+`
+
+type UpdateLinkParams struct {
+	Title  pgtype.Text `db:"title" json:"title"`
+	Url    pgtype.Text `db:"url" json:"url"`
+	ID     uuid.UUID   `db:"id" json:"id"`
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UpdateLink(ctx context.Context, arg UpdateLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateLink,
+		arg.Title,
+		arg.Url,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateLinkURL = `-- name: UpdateLinkURL :exec
+UPDATE links
+SET
+    "url" = $1
+WHERE
+    id = $2
+`
+
+type UpdateLinkURLParams struct {
+	Url string    `db:"url" json:"url"`
+	ID  uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateLinkURL(ctx context.Context, arg UpdateLinkURLParams) error {
+	_, err := q.db.Exec(ctx, updateLinkURL, arg.Url, arg.ID)
+	return err
+}
+
+const updateParticipantStatus = `-- name: UpdateParticipantStatus :exec
+UPDATE participants
+SET
+    "status" = $1::rsvp_status,
+    "confirmed_at" = CASE WHEN $1::rsvp_status = 'confirmed' THEN NOW() ELSE "confirmed_at" END,
+    "declined_at" = CASE WHEN $1::rsvp_status = 'declined' THEN NOW() ELSE "declined_at" END,
+    "maybe_at" = CASE WHEN $1::rsvp_status = 'maybe' THEN NOW() ELSE "maybe_at" END,
+    "name" = COALESCE($2, "name"),
+    "phone" = COALESCE($3, "phone")
+WHERE
+    id = $4
+`
+
+type UpdateParticipantStatusParams struct {
+	Status RsvpStatus  `db:"status" json:"status"`
+	Name   pgtype.Text `db:"name" json:"name"`
+	Phone  pgtype.Text `db:"phone" json:"phone"`
+	ID     uuid.UUID   `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateParticipantStatus(ctx context.Context, arg UpdateParticipantStatusParams) error {
+	_, err := q.db.Exec(ctx, updateParticipantStatus,
+		arg.Status,
+		arg.Name,
+		arg.Phone,
+		arg.ID,
+	)
+	return err
+}
+
+const updateTrip = `-- name: UpdateTrip :exec
+UPDATE trips
+SET
+    "destination" = $1,
+    "ends_at" = $2,
+    "starts_at" = $3,
+    "time_zone" = $4,
+    "base_currency" = $5
+WHERE
+    id = $6
+`
+
+type UpdateTripParams struct {
+	Destination  string             `db:"destination" json:"destination"`
+	EndsAt       pgtype.Timestamptz `db:"ends_at" json:"ends_at"`
+	StartsAt     pgtype.Timestamptz `db:"starts_at" json:"starts_at"`
+	TimeZone     string             `db:"time_zone" json:"time_zone"`
+	BaseCurrency string             `db:"base_currency" json:"base_currency"`
+	ID           uuid.UUID          `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
+	_, err := q.db.Exec(ctx, updateTrip,
+		arg.Destination,
 		arg.EndsAt,
 		arg.StartsAt,
-		arg.IsConfirmed,
+		arg.TimeZone,
+		arg.BaseCurrency,
 		arg.ID,
 	)
 	return err
 }
+
+const updateTripStatus = `-- name: UpdateTripStatus :execrows
+UPDATE trips
+SET
+    "status" = $1
+WHERE
+    id = $2
+    AND "status" = $3
+`
+
+type UpdateTripStatusParams struct {
+	Status        TripStatus `db:"status" json:"status"`
+	ID            uuid.UUID  `db:"id" json:"id"`
+	CurrentStatus TripStatus `db:"current_status" json:"current_status"`
+}
+
+func (q *Queries) UpdateTripStatus(ctx context.Context, arg UpdateTripStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripStatus, arg.Status, arg.ID, arg.CurrentStatus)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTripTemplate = `-- name: UpdateTripTemplate :exec
+UPDATE trip_templates
+SET
+    "name" = $1,
+    "updated_at" = NOW()
+WHERE
+    id = $2
+`
+
+type UpdateTripTemplateParams struct {
+	Name string    `db:"name" json:"name"`
+	ID   uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateTripTemplate(ctx context.Context, arg UpdateTripTemplateParams) error {
+	_, err := q.db.Exec(ctx, updateTripTemplate, arg.Name, arg.ID)
+	return err
+}
+
+const upsertActivityProposalVote = `-- name: UpsertActivityProposalVote :exec
+INSERT INTO activity_proposal_votes
+    ("proposal_id", "email", "value", "option_id") VALUES
+    ($1, $2, $3, $4)
+ON CONFLICT ("proposal_id", LOWER("email")) DO UPDATE
+SET
+    "value" = EXCLUDED.value,
+    "option_id" = EXCLUDED.option_id,
+    "voted_at" = NOW()
+`
+
+type UpsertActivityProposalVoteParams struct {
+	ProposalID uuid.UUID   `db:"proposal_id" json:"proposal_id"`
+	Email      string      `db:"email" json:"email"`
+	Value      pgtype.Int2 `db:"value" json:"value"`
+	OptionID   pgtype.UUID `db:"option_id" json:"option_id"`
+}
+
+func (q *Queries) UpsertActivityProposalVote(ctx context.Context, arg UpsertActivityProposalVoteParams) error {
+	_, err := q.db.Exec(ctx, upsertActivityProposalVote,
+		arg.ProposalID,
+		arg.Email,
+		arg.Value,
+		arg.OptionID,
+	)
+	return err
+}
+
+const upsertExchangeRate = `-- name: UpsertExchangeRate :exec
+INSERT INTO exchange_rates
+    ("base_currency", "quote_currency", "effective_on", "rate") VALUES
+    ($1, $2, $3, $4)
+ON CONFLICT ("base_currency", "quote_currency", "effective_on") DO UPDATE
+SET
+    "rate" = EXCLUDED.rate
+`
+
+type UpsertExchangeRateParams struct {
+	BaseCurrency  string         `db:"base_currency" json:"base_currency"`
+	QuoteCurrency string         `db:"quote_currency" json:"quote_currency"`
+	EffectiveOn   pgtype.Date    `db:"effective_on" json:"effective_on"`
+	Rate          pgtype.Numeric `db:"rate" json:"rate"`
+}
+
+func (q *Queries) UpsertExchangeRate(ctx context.Context, arg UpsertExchangeRateParams) error {
+	_, err := q.db.Exec(ctx, upsertExchangeRate,
+		arg.BaseCurrency,
+		arg.QuoteCurrency,
+		arg.EffectiveOn,
+		arg.Rate,
+	)
+	return err
+}
+
+const useToken = `-- name: UseToken :execrows
+INSERT INTO used_tokens
+( "id", "expires_at" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("id") DO NOTHING
+`
+
+type UseTokenParams struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	ExpiresAt pgtype.Timestamp `db:"expires_at" json:"expires_at"`
+}
+
+func (q *Queries) UseToken(ctx context.Context, arg UseTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useToken, arg.ID, arg.ExpiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -1,12 +1,12 @@
 -- name: InsertTrip :one
 INSERT INTO trips
-    ("destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ($1, $2, $3, $4, $5)
+    ("destination", "owner_email", "owner_name", "starts_at", "ends_at", "time_zone", "base_currency") VALUES
+    ($1, $2, $3, $4, $5, $6, $7)
 RETURNING "id";
 
 -- name: GetTrip :one
 SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "status", "time_zone", "base_currency"
 FROM trips
 WHERE
     id = $1;
@@ -17,28 +17,34 @@ SET
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
+    "time_zone" = $4,
+    "base_currency" = $5
 WHERE
-    id = $5;
+    id = $6;
 
 -- name: GetParticipant :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "status", "invited_at", "confirmed_at", "declined_at", "maybe_at", "name", "phone"
 FROM participants
 WHERE
     id = $1;
 
--- name: ConfirmParticipant :exec
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+-- name: UpdateParticipantStatus :exec
+UPDATE participants
+SET
+    "status" = sqlc.arg(status)::rsvp_status,
+    "confirmed_at" = CASE WHEN sqlc.arg(status)::rsvp_status = 'confirmed' THEN NOW() ELSE "confirmed_at" END,
+    "declined_at" = CASE WHEN sqlc.arg(status)::rsvp_status = 'declined' THEN NOW() ELSE "declined_at" END,
+    "maybe_at" = CASE WHEN sqlc.arg(status)::rsvp_status = 'maybe' THEN NOW() ELSE "maybe_at" END,
+    "name" = COALESCE(sqlc.narg(name), "name"),
+    "phone" = COALESCE(sqlc.narg(phone), "phone")
 WHERE
-    id = $1;
+    id = sqlc.arg(id);
 
 
 -- name: GetParticipants :many
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "status", "invited_at", "confirmed_at", "declined_at", "maybe_at", "name", "phone"
 FROM participants
 WHERE
     trip_id = $1;
@@ -48,6 +54,12 @@ INSERT INTO participants
 ( "trip_id", "email" ) VALUES
     ( $1, $2 );
 
+-- name: InviteParticipantToTrip :one
+INSERT INTO participants
+( "trip_id", "email" ) VALUES
+    ( $1, $2 )
+RETURNING "id";
+
 -- name: CreateActivity :one
 INSERT INTO activities
 ( "trip_id", "title", "occurs_at" ) VALUES
@@ -59,7 +71,8 @@ SELECT
     "id", "trip_id", "title", "occurs_at"
 FROM activities
 WHERE
-    trip_id = $1;
+    trip_id = $1
+ORDER BY "occurs_at" ASC;
 
 -- name: CreateTripLink :one
 INSERT INTO links
@@ -69,7 +82,809 @@ RETURNING "id";
 
 -- name: GetTripLinks :many
 SELECT
-    "id", "trip_id", "title", "url"
+    "id", "trip_id", "title", "url", "preview_title", "preview_description", "preview_image_url", "favicon_url", "unfurled_at", "unfurl_locked_until"
 FROM links
 WHERE
-    trip_id = $1;
\ No newline at end of file
+    trip_id = $1
+ORDER BY "id";
+
+-- name: EnqueueMailJob :exec
+INSERT INTO mail_jobs
+( "kind", "payload" ) VALUES
+    ( $1, $2 );
+
+-- name: ClaimMailJobs :many
+UPDATE mail_jobs
+SET
+    "attempts" = "attempts" + 1,
+    "locked_until" = NOW() + INTERVAL '5 minutes'
+WHERE id IN (
+    SELECT id
+    FROM mail_jobs
+    WHERE
+        status = 'pending'
+        AND run_at <= NOW()
+        AND (locked_until IS NULL OR locked_until < NOW())
+    ORDER BY run_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING "id", "kind", "payload", "status", "attempts", "last_error", "run_at", "locked_until", "created_at";
+
+-- name: MarkMailJobAsSent :exec
+UPDATE mail_jobs
+SET
+    "status" = 'sent',
+    "locked_until" = NULL
+WHERE
+    id = $1;
+
+-- name: RetryMailJob :exec
+UPDATE mail_jobs
+SET
+    "last_error" = sqlc.arg(last_error),
+    "run_at" = NOW() + sqlc.arg(backoff)::interval,
+    "locked_until" = NULL
+WHERE
+    id = sqlc.arg(id);
+
+-- name: MarkMailJobAsDead :exec
+UPDATE mail_jobs
+SET
+    "status" = 'dead',
+    "last_error" = $1,
+    "locked_until" = NULL
+WHERE
+    id = $2;
+
+-- name: GetDeadMailJobs :many
+SELECT
+    "id", "kind", "payload", "status", "attempts", "last_error", "run_at", "locked_until", "created_at"
+FROM mail_jobs
+WHERE
+    status = 'dead'
+ORDER BY created_at;
+
+-- name: RequeueMailJob :execrows
+UPDATE mail_jobs
+SET
+    "status" = 'pending',
+    "attempts" = 0,
+    "run_at" = NOW(),
+    "locked_until" = NULL
+WHERE
+    id = $1
+    AND status = 'dead';
+
+-- name: UseToken :execrows
+INSERT INTO used_tokens
+( "id", "expires_at" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("id") DO NOTHING;
+
+-- name: IsTokenUsed :one
+SELECT EXISTS (
+    SELECT 1 FROM used_tokens WHERE id = $1
+);
+
+-- name: IsKnownEmail :one
+SELECT EXISTS (
+    SELECT 1 FROM trips WHERE LOWER(owner_email) = LOWER(sqlc.arg(email))
+    UNION ALL
+    SELECT 1 FROM participants WHERE LOWER(email) = LOWER(sqlc.arg(email))
+);
+
+-- name: IsTripMember :one
+SELECT EXISTS (
+    SELECT 1
+    FROM participants
+    WHERE
+        trip_id = sqlc.arg(trip_id)
+        AND LOWER(email) = LOWER(sqlc.arg(email))
+);
+
+-- name: DeleteTrip :execrows
+DELETE FROM trips
+WHERE
+    id = $1;
+
+-- name: DeleteActivity :execrows
+DELETE FROM activities
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: DeleteLink :execrows
+DELETE FROM links
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: DeleteParticipant :execrows
+DELETE FROM participants
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: UpdateActivity :execrows
+UPDATE activities
+SET
+    "title" = COALESCE(sqlc.narg(title), "title"),
+    "occurs_at" = COALESCE(sqlc.narg(occurs_at), "occurs_at")
+WHERE
+    id = sqlc.arg(id)
+    AND trip_id = sqlc.arg(trip_id);
+
+-- name: UpdateLink :execrows
+UPDATE links
+SET
+    "title" = COALESCE(sqlc.narg(title), "title"),
+    "url" = COALESCE(sqlc.narg(url), "url"),
+    -- a new url needs a new preview, which the unfurler picks up once unfurled_at is cleared
+    "preview_title" = CASE WHEN COALESCE(sqlc.narg(url), "url") = "url" THEN "preview_title" END,
+    "preview_description" = CASE WHEN COALESCE(sqlc.narg(url), "url") = "url" THEN "preview_description" END,
+    "preview_image_url" = CASE WHEN COALESCE(sqlc.narg(url), "url") = "url" THEN "preview_image_url" END,
+    "favicon_url" = CASE WHEN COALESCE(sqlc.narg(url), "url") = "url" THEN "favicon_url" END,
+    "unfurled_at" = CASE WHEN COALESCE(sqlc.narg(url), "url") = "url" THEN "unfurled_at" END
+WHERE
+    id = sqlc.arg(id)
+    AND trip_id = sqlc.arg(trip_id);
+
+-- name: ShiftTripActivities :exec
+UPDATE activities
+SET
+    "occurs_at" = "occurs_at" + sqlc.arg(shift)::interval
+WHERE
+    trip_id = sqlc.arg(trip_id);
+
+-- name: DeleteTripActivities :execrows
+DELETE FROM activities
+WHERE
+    trip_id = sqlc.arg(trip_id)
+    AND id = ANY(sqlc.arg(ids)::uuid[]);
+
+-- name: UpdateTripStatus :execrows
+UPDATE trips
+SET
+    "status" = sqlc.arg(status)
+WHERE
+    id = sqlc.arg(id)
+    AND "status" = sqlc.arg(current_status);
+
+-- name: CompleteEndedTrips :execrows
+UPDATE trips
+SET
+    "status" = 'completed'
+WHERE
+    "status" = 'confirmed'
+    AND "ends_at" < NOW();
+
+-- name: ListTrips :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "status", "time_zone", "base_currency"
+FROM trips
+WHERE
+    (
+        sqlc.narg(member_email)::text IS NULL
+        OR LOWER(owner_email) = LOWER(sqlc.narg(member_email))
+        OR EXISTS (
+            SELECT 1 FROM participants
+            WHERE participants.trip_id = trips.id AND LOWER(participants.email) = LOWER(sqlc.narg(member_email))
+        )
+    )
+    AND (sqlc.narg(owner_email)::text IS NULL OR LOWER(owner_email) = LOWER(sqlc.narg(owner_email)))
+    AND (
+        sqlc.narg(participant_email)::text IS NULL
+        OR EXISTS (
+            SELECT 1 FROM participants
+            WHERE participants.trip_id = trips.id AND LOWER(participants.email) = LOWER(sqlc.narg(participant_email))
+        )
+    )
+    -- confirmed trips that have already ended are filtered as completed, which is how currentTripStatus reports them
+    AND (
+        sqlc.narg(status)::trip_status IS NULL
+        OR (CASE WHEN "status" = 'confirmed' AND "ends_at" < NOW() THEN 'completed'::trip_status ELSE "status" END) = sqlc.narg(status)
+    )
+    AND (sqlc.narg(starts_after)::timestamptz IS NULL OR "starts_at" >= sqlc.narg(starts_after))
+    AND (sqlc.narg(ends_before)::timestamptz IS NULL OR "ends_at" <= sqlc.narg(ends_before))
+    AND (
+        sqlc.narg(cursor_starts_at)::timestamptz IS NULL
+        OR ("starts_at", "id") > (sqlc.narg(cursor_starts_at), sqlc.narg(cursor_id)::uuid)
+    )
+ORDER BY "starts_at" ASC, "id" ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListTripsDesc :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "status", "time_zone", "base_currency"
+FROM trips
+WHERE
+    (
+        sqlc.narg(member_email)::text IS NULL
+        OR LOWER(owner_email) = LOWER(sqlc.narg(member_email))
+        OR EXISTS (
+            SELECT 1 FROM participants
+            WHERE participants.trip_id = trips.id AND LOWER(participants.email) = LOWER(sqlc.narg(member_email))
+        )
+    )
+    AND (sqlc.narg(owner_email)::text IS NULL OR LOWER(owner_email) = LOWER(sqlc.narg(owner_email)))
+    AND (
+        sqlc.narg(participant_email)::text IS NULL
+        OR EXISTS (
+            SELECT 1 FROM participants
+            WHERE participants.trip_id = trips.id AND LOWER(participants.email) = LOWER(sqlc.narg(participant_email))
+        )
+    )
+    -- completed trips are filtered the same way as in ListTrips
+    AND (
+        sqlc.narg(status)::trip_status IS NULL
+        OR (CASE WHEN "status" = 'confirmed' AND "ends_at" < NOW() THEN 'completed'::trip_status ELSE "status" END) = sqlc.narg(status)
+    )
+    AND (sqlc.narg(starts_after)::timestamptz IS NULL OR "starts_at" >= sqlc.narg(starts_after))
+    AND (sqlc.narg(ends_before)::timestamptz IS NULL OR "ends_at" <= sqlc.narg(ends_before))
+    AND (
+        sqlc.narg(cursor_starts_at)::timestamptz IS NULL
+        OR ("starts_at", "id") < (sqlc.narg(cursor_starts_at), sqlc.narg(cursor_id)::uuid)
+    )
+ORDER BY "starts_at" DESC, "id" DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: CreateCalendarFeed :one
+INSERT INTO calendar_feeds
+    ("email", "token_hash") VALUES
+    ($1, $2)
+RETURNING "id";
+
+-- name: RevokeCalendarFeeds :execrows
+UPDATE calendar_feeds
+SET
+    "revoked_at" = NOW()
+WHERE
+    LOWER(email) = LOWER(sqlc.arg(email))
+    AND revoked_at IS NULL;
+
+-- name: GetCalendarFeedByTokenHash :one
+SELECT
+    "id", "email", "token_hash", "created_at", "revoked_at"
+FROM calendar_feeds
+WHERE
+    token_hash = $1
+    AND revoked_at IS NULL;
+
+-- name: GetMemberTrips :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "status", "time_zone", "base_currency"
+FROM trips
+WHERE
+    LOWER(owner_email) = LOWER(sqlc.arg(email))
+    OR EXISTS (
+        SELECT 1 FROM participants
+        WHERE
+            participants.trip_id = trips.id
+            AND LOWER(participants.email) = LOWER(sqlc.arg(email))
+            AND participants.status <> 'declined'
+    )
+ORDER BY "starts_at" ASC, "id" ASC;
+
+-- name: GetActivitiesOfTrips :many
+SELECT
+    "id", "trip_id", "title", "occurs_at"
+FROM activities
+WHERE
+    trip_id = ANY(sqlc.arg(trip_ids)::uuid[])
+ORDER BY "trip_id" ASC, "occurs_at" ASC, "id" ASC;
+
+-- name: GetLinksOfTrips :many
+SELECT
+    "id", "trip_id", "title", "url", "preview_title", "preview_description", "preview_image_url", "favicon_url", "unfurled_at", "unfurl_locked_until"
+FROM links
+WHERE
+    trip_id = ANY(sqlc.arg(trip_ids)::uuid[])
+ORDER BY "trip_id" ASC, "id" ASC;
+
+-- name: GetAllLinksForUpdate :many
+SELECT
+    "id", "trip_id", "title", "url", "preview_title", "preview_description", "preview_image_url", "favicon_url", "unfurled_at", "unfurl_locked_until"
+FROM links
+ORDER BY "trip_id" ASC, "id" ASC
+FOR UPDATE;
+
+-- name: UpdateLinkURL :exec
+UPDATE links
+SET
+    "url" = $1
+WHERE
+    id = $2;
+
+-- name: InsertImportedTrip :one
+INSERT INTO trips
+    ("destination", "owner_email", "owner_name", "starts_at", "ends_at", "time_zone", "status", "base_currency") VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING "id";
+
+-- name: CopyTripActivities :exec
+INSERT INTO activities
+    ("trip_id", "title", "occurs_at")
+SELECT
+    sqlc.arg(new_trip_id), "title",
+    (("occurs_at" AT TIME ZONE sqlc.arg(time_zone)::text) + sqlc.arg(days)::integer * INTERVAL '1 day') AT TIME ZONE sqlc.arg(time_zone)::text
+FROM activities
+WHERE
+    trip_id = sqlc.arg(trip_id);
+
+-- name: CopyTripLinks :exec
+INSERT INTO links
+    ("trip_id", "title", "url")
+SELECT
+    sqlc.arg(new_trip_id), "title", "url"
+FROM links
+WHERE
+    trip_id = sqlc.arg(trip_id);
+
+-- name: CopyTripParticipants :exec
+INSERT INTO participants
+    ("trip_id", "email", "name", "phone")
+SELECT
+    sqlc.arg(new_trip_id), "email", "name", "phone"
+FROM participants
+WHERE
+    trip_id = sqlc.arg(trip_id);
+
+-- name: InsertTripTemplate :one
+INSERT INTO trip_templates
+    ("owner_email", "name") VALUES
+    ($1, $2)
+RETURNING "id";
+
+-- name: GetTripTemplate :one
+SELECT
+    "id", "owner_email", "name", "created_at", "updated_at"
+FROM trip_templates
+WHERE
+    id = $1;
+
+-- name: ListTripTemplates :many
+SELECT
+    "id", "owner_email", "name", "created_at", "updated_at"
+FROM trip_templates
+WHERE
+    LOWER(owner_email) = LOWER(sqlc.arg(owner_email))
+ORDER BY "name" ASC, "id" ASC;
+
+-- name: UpdateTripTemplate :exec
+UPDATE trip_templates
+SET
+    "name" = $1,
+    "updated_at" = NOW()
+WHERE
+    id = $2;
+
+-- name: DeleteTripTemplate :execrows
+DELETE FROM trip_templates
+WHERE
+    id = $1;
+
+-- name: InsertTripTemplateActivity :exec
+INSERT INTO trip_template_activities
+    ("template_id", "title", "day", "time") VALUES
+    ($1, $2, $3, $4);
+
+-- name: GetTripTemplateActivities :many
+SELECT
+    "id", "template_id", "title", "day", "time"
+FROM trip_template_activities
+WHERE
+    template_id = $1
+ORDER BY "day" ASC, "time" ASC;
+
+-- name: DeleteTripTemplateActivities :exec
+DELETE FROM trip_template_activities
+WHERE
+    template_id = $1;
+
+-- name: InsertTripTemplateLink :exec
+INSERT INTO trip_template_links
+    ("template_id", "title", "url") VALUES
+    ($1, $2, $3);
+
+-- name: GetTripTemplateLinks :many
+SELECT
+    "id", "template_id", "title", "url"
+FROM trip_template_links
+WHERE
+    template_id = $1;
+
+-- name: DeleteTripTemplateLinks :exec
+DELETE FROM trip_template_links
+WHERE
+    template_id = $1;
+
+-- name: ClaimLinksToUnfurl :many
+UPDATE links
+SET
+    "unfurl_locked_until" = NOW() + INTERVAL '1 minute'
+WHERE id IN (
+    SELECT id
+    FROM links
+    WHERE
+        unfurled_at IS NULL
+        AND (unfurl_locked_until IS NULL OR unfurl_locked_until < NOW())
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING "id", "url";
+
+-- name: SaveLinkPreview :exec
+UPDATE links
+SET
+    "preview_title" = sqlc.arg(preview_title),
+    "preview_description" = sqlc.arg(preview_description),
+    "preview_image_url" = sqlc.arg(preview_image_url),
+    "favicon_url" = sqlc.arg(favicon_url),
+    "unfurled_at" = NOW(),
+    "unfurl_locked_until" = NULL
+WHERE
+    id = sqlc.arg(id)
+    AND url = sqlc.arg(url);
+
+-- name: InsertExpense :one
+INSERT INTO expenses
+    ("trip_id", "activity_id", "payer_email", "description", "amount", "currency", "split_kind", "category",
+     "base_currency", "exchange_rate", "base_amount") VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING "id";
+
+-- name: UpdateExpense :execrows
+UPDATE expenses
+SET
+    "activity_id" = sqlc.arg(activity_id),
+    "payer_email" = sqlc.arg(payer_email),
+    "description" = sqlc.arg(description),
+    "amount" = sqlc.arg(amount),
+    "currency" = sqlc.arg(currency),
+    "split_kind" = sqlc.arg(split_kind),
+    "category" = sqlc.arg(category),
+    "base_currency" = sqlc.arg(base_currency),
+    "exchange_rate" = sqlc.arg(exchange_rate),
+    "base_amount" = sqlc.arg(base_amount),
+    "updated_at" = NOW()
+WHERE
+    id = sqlc.arg(id)
+    AND trip_id = sqlc.arg(trip_id);
+
+-- name: GetExpense :one
+SELECT
+    "id", "trip_id", "activity_id", "payer_email", "description", "amount", "currency", "split_kind", "created_at", "updated_at",
+    "category", "base_currency", "exchange_rate", "base_amount"
+FROM expenses
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: GetTripExpenses :many
+SELECT
+    "id", "trip_id", "activity_id", "payer_email", "description", "amount", "currency", "split_kind", "created_at", "updated_at",
+    "category", "base_currency", "exchange_rate", "base_amount"
+FROM expenses
+WHERE
+    trip_id = $1
+ORDER BY created_at, id;
+
+-- name: DeleteExpense :execrows
+DELETE FROM expenses
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: InsertExpenseSplit :exec
+INSERT INTO expense_splits
+    ("expense_id", "email", "position", "shares", "amount") VALUES
+    ($1, $2, $3, $4, $5);
+
+-- name: DeleteExpenseSplits :exec
+DELETE FROM expense_splits
+WHERE
+    expense_id = $1;
+
+-- name: GetExpenseSplits :many
+SELECT
+    "id", "expense_id", "email", "position", "shares", "amount"
+FROM expense_splits
+WHERE
+    expense_id = $1
+ORDER BY position;
+
+-- name: GetTripExpenseSplits :many
+SELECT
+    expense_splits.id, expense_splits.expense_id, expense_splits.email, expense_splits.position, expense_splits.shares, expense_splits.amount
+FROM expense_splits
+JOIN expenses ON expenses.id = expense_splits.expense_id
+WHERE
+    expenses.trip_id = $1
+ORDER BY expense_splits.expense_id, expense_splits.position;
+
+-- name: IsTripActivity :one
+SELECT EXISTS (
+    SELECT 1
+    FROM activities
+    WHERE
+        id = sqlc.arg(id)
+        AND trip_id = sqlc.arg(trip_id)
+);
+
+-- name: InsertSettlement :one
+INSERT INTO settlements
+    ("trip_id", "from_email", "to_email", "amount", "currency") VALUES
+    ($1, $2, $3, $4, $5)
+RETURNING "id";
+
+-- name: GetTripSettlements :many
+SELECT
+    "id", "trip_id", "from_email", "to_email", "amount", "currency", "created_at"
+FROM settlements
+WHERE
+    trip_id = $1
+ORDER BY created_at, id;
+
+-- name: DeleteSettlement :execrows
+DELETE FROM settlements
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: UpsertExchangeRate :exec
+INSERT INTO exchange_rates
+    ("base_currency", "quote_currency", "effective_on", "rate") VALUES
+    ($1, $2, $3, $4)
+ON CONFLICT ("base_currency", "quote_currency", "effective_on") DO UPDATE
+SET
+    "rate" = EXCLUDED.rate;
+
+-- name: GetExchangeRate :one
+SELECT
+    "base_currency", "quote_currency", "effective_on", "rate"
+FROM exchange_rates
+WHERE
+    (
+        (base_currency = sqlc.arg(from_currency) AND quote_currency = sqlc.arg(to_currency))
+        OR (base_currency = sqlc.arg(to_currency) AND quote_currency = sqlc.arg(from_currency))
+    )
+    AND effective_on <= sqlc.arg(effective_on)
+ORDER BY effective_on DESC, base_currency = sqlc.arg(from_currency) DESC
+LIMIT 1;
+
+-- name: InsertTripBudget :exec
+INSERT INTO trip_budgets
+    ("trip_id", "category", "amount", "currency", "base_currency", "exchange_rate", "base_amount") VALUES
+    ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: DeleteTripBudgets :exec
+DELETE FROM trip_budgets
+WHERE
+    trip_id = $1;
+
+-- name: GetTripBudgets :many
+SELECT
+    "id", "trip_id", "category", "amount", "currency", "base_currency", "exchange_rate", "base_amount"
+FROM trip_budgets
+WHERE
+    trip_id = $1
+ORDER BY category;
+
+-- name: TripHasExpensesOrBudgets :one
+SELECT EXISTS (
+    SELECT 1 FROM expenses WHERE trip_id = sqlc.arg(trip_id)
+    UNION ALL
+    SELECT 1 FROM trip_budgets WHERE trip_id = sqlc.arg(trip_id)
+);
+
+-- name: InsertChecklist :one
+INSERT INTO checklists
+    ("trip_id", "title", "kind") VALUES
+    ($1, $2, $3)
+RETURNING "id";
+
+-- name: UpdateChecklist :execrows
+UPDATE checklists
+SET
+    "title" = $1,
+    "kind" = $2
+WHERE
+    id = $3
+    AND trip_id = $4;
+
+-- name: DeleteChecklist :execrows
+DELETE FROM checklists
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: GetTripChecklists :many
+SELECT
+    "id", "trip_id", "title", "kind", "created_at"
+FROM checklists
+WHERE
+    trip_id = $1
+ORDER BY created_at, id;
+
+-- name: IsTripChecklist :one
+SELECT EXISTS (
+    SELECT 1
+    FROM checklists
+    WHERE
+        id = sqlc.arg(id)
+        AND trip_id = sqlc.arg(trip_id)
+);
+
+-- name: InsertChecklistItem :one
+INSERT INTO checklist_items
+    ("checklist_id", "title", "group_name", "assignee_email", "is_done", "done_at", "position") VALUES
+    ($1, $2, $3, $4, $5, CASE WHEN $5 THEN NOW() END, (
+        SELECT COALESCE(MAX(position) + 1, 0) FROM checklist_items WHERE checklist_id = $1
+    ))
+RETURNING "id";
+
+-- name: UpdateChecklistItem :execrows
+UPDATE checklist_items
+SET
+    "title" = $1,
+    "group_name" = $2,
+    "assignee_email" = $3,
+    "is_done" = $4,
+    "done_at" = CASE WHEN $4 THEN COALESCE(done_at, NOW()) END
+WHERE
+    id = $5
+    AND checklist_id = $6;
+
+-- name: SetChecklistItemPosition :exec
+UPDATE checklist_items
+SET
+    "position" = $1
+WHERE
+    id = $2
+    AND checklist_id = $3;
+
+-- name: DeleteChecklistItem :execrows
+DELETE FROM checklist_items
+WHERE
+    id = $1
+    AND checklist_id = $2;
+
+-- name: GetChecklistItems :many
+SELECT
+    "id", "checklist_id", "title", "group_name", "assignee_email", "is_done", "done_at", "position", "created_at"
+FROM checklist_items
+WHERE
+    checklist_id = $1
+ORDER BY position, created_at, id;
+
+-- name: GetTripChecklistItems :many
+SELECT
+    checklist_items.id, checklist_items.checklist_id, checklist_items.title, checklist_items.group_name,
+    checklist_items.assignee_email, checklist_items.is_done, checklist_items.done_at, checklist_items.position,
+    checklist_items.created_at
+FROM checklist_items
+JOIN checklists ON checklists.id = checklist_items.checklist_id
+WHERE
+    checklists.trip_id = $1
+ORDER BY checklist_items.checklist_id, checklist_items.position, checklist_items.created_at, checklist_items.id;
+
+-- name: GetOpenChecklistItemsAssignedTo :many
+SELECT
+    checklists.title AS checklist_title, checklist_items.title, checklist_items.group_name
+FROM checklist_items
+JOIN checklists ON checklists.id = checklist_items.checklist_id
+WHERE
+    checklists.trip_id = sqlc.arg(trip_id)
+    AND LOWER(checklist_items.assignee_email) = LOWER(sqlc.arg(email))
+    AND NOT checklist_items.is_done
+ORDER BY checklists.created_at, checklists.id, checklist_items.position, checklist_items.created_at;
+
+-- name: InsertActivityProposal :one
+INSERT INTO activity_proposals
+    ("trip_id", "title", "occurs_at", "proposed_by") VALUES
+    ($1, $2, $3, $4)
+RETURNING "id";
+
+-- name: InsertActivityProposalOption :exec
+INSERT INTO activity_proposal_options
+    ("proposal_id", "label", "occurs_at", "position") VALUES
+    ($1, $2, $3, $4);
+
+-- name: GetActivityProposal :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "proposed_by", "activity_id", "promoted_at", "created_at"
+FROM activity_proposals
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: GetTripActivityProposals :many
+SELECT
+    "id", "trip_id", "title", "occurs_at", "proposed_by", "activity_id", "promoted_at", "created_at"
+FROM activity_proposals
+WHERE
+    trip_id = $1
+ORDER BY created_at, id;
+
+-- name: DeleteActivityProposal :execrows
+DELETE FROM activity_proposals
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: MarkActivityProposalAsPromoted :execrows
+UPDATE activity_proposals
+SET
+    "activity_id" = $1,
+    "promoted_at" = NOW()
+WHERE
+    id = $2
+    AND promoted_at IS NULL;
+
+-- name: GetActivityProposalOptions :many
+SELECT
+    "id", "proposal_id", "label", "occurs_at", "position"
+FROM activity_proposal_options
+WHERE
+    proposal_id = $1
+ORDER BY position;
+
+-- name: GetTripActivityProposalOptions :many
+SELECT
+    activity_proposal_options.id, activity_proposal_options.proposal_id, activity_proposal_options.label,
+    activity_proposal_options.occurs_at, activity_proposal_options.position
+FROM activity_proposal_options
+JOIN activity_proposals ON activity_proposals.id = activity_proposal_options.proposal_id
+WHERE
+    activity_proposals.trip_id = $1
+ORDER BY activity_proposal_options.proposal_id, activity_proposal_options.position;
+
+-- name: UpsertActivityProposalVote :exec
+INSERT INTO activity_proposal_votes
+    ("proposal_id", "email", "value", "option_id") VALUES
+    ($1, $2, $3, $4)
+ON CONFLICT ("proposal_id", LOWER("email")) DO UPDATE
+SET
+    "value" = EXCLUDED.value,
+    "option_id" = EXCLUDED.option_id,
+    "voted_at" = NOW();
+
+-- name: DeleteActivityProposalVote :execrows
+DELETE FROM activity_proposal_votes
+WHERE
+    proposal_id = sqlc.arg(proposal_id)
+    AND LOWER(email) = LOWER(sqlc.arg(email));
+
+-- name: GetTripActivityProposalVotes :many
+SELECT
+    activity_proposal_votes.id, activity_proposal_votes.proposal_id, activity_proposal_votes.email,
+    activity_proposal_votes.value, activity_proposal_votes.option_id, activity_proposal_votes.voted_at
+FROM activity_proposal_votes
+JOIN activity_proposals ON activity_proposals.id = activity_proposal_votes.proposal_id
+JOIN trips ON trips.id = activity_proposals.trip_id
+WHERE
+    activity_proposals.trip_id = $1
+    -- votes of participants who declined or were removed after voting are kept, but only count while they are confirmed
+    AND (
+        LOWER(activity_proposal_votes.email) = LOWER(trips.owner_email)
+        OR EXISTS (
+            SELECT 1
+            FROM participants
+            WHERE
+                participants.trip_id = trips.id
+                AND LOWER(participants.email) = LOWER(activity_proposal_votes.email)
+                AND participants.status = 'confirmed'
+        )
+    )
+ORDER BY activity_proposal_votes.proposal_id, activity_proposal_votes.voted_at;
+
+-- name: IsConfirmedParticipant :one
+SELECT EXISTS (
+    SELECT 1
+    FROM participants
+    WHERE
+        trip_id = sqlc.arg(trip_id)
+        AND LOWER(email) = LOWER(sqlc.arg(email))
+        AND status = 'confirmed'
+);
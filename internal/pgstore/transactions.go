@@ -2,14 +2,24 @@ package pgstore
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"nlw-journey/internal/api/spec"
+	"time"
 )
 
-func (selfQueries *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.PostTripsJSONBody) (uuid.UUID, error) {
+// DefaultTimeZone is used for trips created without a time zone.
+const DefaultTimeZone = "UTC"
+
+// DefaultBaseCurrency is used for trips created without a base currency.
+const DefaultBaseCurrency = "BRL"
+
+// CreateTrip creates the trip, its participants and, when the trip comes from a template, the template's activities and
+// links. The trip ID of activities and links is filled in once the trip is inserted.
+func (selfQueries *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.PostTripsJSONBody, activities []CreateActivityParams, links []CreateTripLinkParams) (uuid.UUID, error) {
 	tx, err := pool.Begin(ctx)
 
 	if err != nil {
@@ -29,19 +39,31 @@ func (selfQueries *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool,
 	// starts a sql transaction
 	selfWithTransaction := selfQueries.WithTx(tx)
 
+	timeZone := DefaultTimeZone
+	if params.TimeZone != nil {
+		timeZone = *params.TimeZone
+	}
+
+	baseCurrency := DefaultBaseCurrency
+	if params.BaseCurrency != nil {
+		baseCurrency = *params.BaseCurrency
+	}
+
 	// insert a trip to the database
 	tripID, err := selfWithTransaction.InsertTrip(ctx, InsertTripParams{
 		Destination: params.Destination,
 		OwnerEmail:  string(params.OwnerEmail),
 		OwnerName:   params.OwnerName,
-		StartsAt: pgtype.Timestamp{
+		StartsAt: pgtype.Timestamptz{
 			Time:  params.StartsAt,
 			Valid: true,
 		},
-		EndsAt: pgtype.Timestamp{
+		EndsAt: pgtype.Timestamptz{
 			Time:  params.EndsAt,
 			Valid: true,
 		},
+		TimeZone:     timeZone,
+		BaseCurrency: baseCurrency,
 	})
 
 	if err != nil {
@@ -62,6 +84,25 @@ func (selfQueries *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool,
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to invite participants to trip: %w", err)
 	}
 
+	for _, activity := range activities {
+		activity.TripID = tripID
+		if _, err := selfWithTransaction.CreateActivity(ctx, activity); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert activity: %w", err)
+		}
+	}
+
+	for _, link := range links {
+		link.TripID = tripID
+		if _, err := selfWithTransaction.CreateTripLink(ctx, link); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert link: %w", err)
+		}
+	}
+
+	// queue the e-mail asking the owner to confirm the trip
+	if err := selfWithTransaction.EnqueueMail(ctx, MailKindConfirmTrip, MailPayload{TripID: tripID}); err != nil {
+		return uuid.UUID{}, err
+	}
+
 	// try to commit (guarantee both queries have been successfully done)
 	if err := tx.Commit(ctx); err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit CreateTrip: %w", err)
@@ -69,3 +110,917 @@ func (selfQueries *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool,
 
 	return tripID, nil
 }
+
+// ErrTripStatusChanged is returned when the trip's status was changed by someone else before it could be updated.
+var ErrTripStatusChanged = errors.New("pgstore: trip status changed concurrently")
+
+// ErrTokenUsed is returned when the confirmation link's token was used by someone else before the confirmation finished.
+var ErrTokenUsed = errors.New("pgstore: token already used")
+
+// ConfirmTrip marks the draft trip as confirmed and queues both the owner's notification and the invitations of every
+// participant that hasn't confirmed their presence yet. When the trip is confirmed through a signed link, its token is
+// recorded as used along with the confirmation, so the link stays valid if the confirmation fails.
+func (selfQueries *Queries) ConfirmTrip(ctx context.Context, pool *pgxpool.Pool, trip Trip, usedToken *UseTokenParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for ConfirmTrip: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	if err := selfWithTransaction.useToken(ctx, usedToken); err != nil {
+		return err
+	}
+
+	if err := selfWithTransaction.changeTripStatus(ctx, trip.ID, TripStatusDraft, TripStatusConfirmed); err != nil {
+		return err
+	}
+
+	if err := selfWithTransaction.EnqueueMail(ctx, MailKindTripConfirmed, MailPayload{TripID: trip.ID}); err != nil {
+		return err
+	}
+
+	participants, err := selfWithTransaction.GetParticipants(ctx, trip.ID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get trip's participants: %w", err)
+	}
+
+	for _, participant := range participants {
+		if participant.Status != RsvpStatusPending {
+			continue
+		}
+
+		if err := selfWithTransaction.EnqueueMail(ctx, MailKindTripInvitation, MailPayload{
+			TripID:        trip.ID,
+			ParticipantID: participant.ID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit ConfirmTrip: %w", err)
+	}
+
+	return nil
+}
+
+// InviteParticipant adds a participant to the trip. Since participants of unconfirmed trips are invited once the
+// owner confirms it, the invitation e-mail is only queued if the trip has already been confirmed.
+func (selfQueries *Queries) InviteParticipant(ctx context.Context, pool *pgxpool.Pool, trip Trip, email string) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin trx for InviteParticipant: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	participantID, err := selfWithTransaction.InviteParticipantToTrip(ctx, InviteParticipantToTripParams{
+		TripID: trip.ID,
+		Email:  email,
+	})
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to invite participant to trip: %w", err)
+	}
+
+	if trip.Status == TripStatusConfirmed {
+		if err := selfWithTransaction.EnqueueMail(ctx, MailKindTripInvitation, MailPayload{
+			TripID:        trip.ID,
+			ParticipantID: participantID,
+		}); err != nil {
+			return uuid.UUID{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit InviteParticipant: %w", err)
+	}
+
+	return participantID, nil
+}
+
+// RemoveParticipant removes a participant from the trip and, if notify is set, queues an e-mail letting them know.
+// It reports whether the participant belonged to the trip.
+func (selfQueries *Queries) RemoveParticipant(ctx context.Context, pool *pgxpool.Pool, participant Participant, notify bool) (bool, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to begin trx for RemoveParticipant: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	deleted, err := selfWithTransaction.DeleteParticipant(ctx, DeleteParticipantParams{
+		ID:     participant.ID,
+		TripID: participant.TripID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to delete participant: %w", err)
+	}
+
+	if deleted == 0 {
+		return false, nil
+	}
+
+	if notify {
+		if err := selfWithTransaction.EnqueueMail(ctx, MailKindParticipantRemoved, MailPayload{
+			TripID: participant.TripID,
+			Email:  participant.Email,
+		}); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("pgstore: failed to commit RemoveParticipant: %w", err)
+	}
+
+	return true, nil
+}
+
+// RescheduleTrip updates the trip and fixes up its activities in a single transaction. Every activity is moved by
+// shift, then the ones listed in droppedActivities are deleted.
+func (selfQueries *Queries) RescheduleTrip(ctx context.Context, pool *pgxpool.Pool, params UpdateTripParams, shift time.Duration, droppedActivities []uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for RescheduleTrip: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	if err := selfWithTransaction.UpdateTrip(ctx, params); err != nil {
+		return fmt.Errorf("pgstore: failed to update trip: %w", err)
+	}
+
+	if shift != 0 {
+		if err := selfWithTransaction.ShiftTripActivities(ctx, ShiftTripActivitiesParams{
+			Shift:  pgtype.Interval{Microseconds: shift.Microseconds(), Valid: true},
+			TripID: params.ID,
+		}); err != nil {
+			return fmt.Errorf("pgstore: failed to shift trip's activities: %w", err)
+		}
+	}
+
+	if len(droppedActivities) > 0 {
+		if _, err := selfWithTransaction.DeleteTripActivities(ctx, DeleteTripActivitiesParams{
+			TripID: params.ID,
+			Ids:    droppedActivities,
+		}); err != nil {
+			return fmt.Errorf("pgstore: failed to drop trip's activities: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit RescheduleTrip: %w", err)
+	}
+
+	return nil
+}
+
+// CancelTrip marks the trip as cancelled. If the trip had been confirmed, its participants have already been invited,
+// so each of them is told about the cancellation by e-mail.
+func (selfQueries *Queries) CancelTrip(ctx context.Context, pool *pgxpool.Pool, trip Trip) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for CancelTrip: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	if err := selfWithTransaction.changeTripStatus(ctx, trip.ID, trip.Status, TripStatusCancelled); err != nil {
+		return err
+	}
+
+	if trip.Status == TripStatusConfirmed {
+		participants, err := selfWithTransaction.GetParticipants(ctx, trip.ID)
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to get trip's participants: %w", err)
+		}
+
+		for _, participant := range participants {
+			if err := selfWithTransaction.EnqueueMail(ctx, MailKindTripCancelled, MailPayload{
+				TripID:        trip.ID,
+				ParticipantID: participant.ID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit CancelTrip: %w", err)
+	}
+
+	return nil
+}
+
+// changeTripStatus moves the trip from one status to another, failing with ErrTripStatusChanged if the trip is no
+// longer in the expected status.
+func (selfQueries *Queries) changeTripStatus(ctx context.Context, tripID uuid.UUID, from TripStatus, to TripStatus) error {
+	updated, err := selfQueries.UpdateTripStatus(ctx, UpdateTripStatusParams{
+		Status:        to,
+		ID:            tripID,
+		CurrentStatus: from,
+	})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to update trip status: %w", err)
+	}
+
+	if updated == 0 {
+		return ErrTripStatusChanged
+	}
+
+	return nil
+}
+
+// AnswerRSVP saves the participant's answer to the invitation. When it is answered through a signed link, its token is
+// recorded as used along with the answer, so the link stays valid if saving the answer fails.
+func (selfQueries *Queries) AnswerRSVP(ctx context.Context, pool *pgxpool.Pool, params UpdateParticipantStatusParams, usedToken *UseTokenParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for AnswerRSVP: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	if err := selfWithTransaction.useToken(ctx, usedToken); err != nil {
+		return err
+	}
+
+	if err := selfWithTransaction.UpdateParticipantStatus(ctx, params); err != nil {
+		return fmt.Errorf("pgstore: failed to update participant status: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit AnswerRSVP: %w", err)
+	}
+
+	return nil
+}
+
+// useToken records the token as used, failing with ErrTokenUsed if it already was. Nothing is recorded if there is no
+// token.
+func (selfQueries *Queries) useToken(ctx context.Context, usedToken *UseTokenParams) error {
+	if usedToken == nil {
+		return nil
+	}
+
+	affected, err := selfQueries.UseToken(ctx, *usedToken)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to use token: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrTokenUsed
+	}
+
+	return nil
+}
+
+// RotateCalendarFeed revokes every calendar feed of the e-mail and creates a new one with the given token hash, so
+// only the newest feed URL keeps working.
+func (selfQueries *Queries) RotateCalendarFeed(ctx context.Context, pool *pgxpool.Pool, email string, tokenHash []byte) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin trx for RotateCalendarFeed: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	if _, err := selfWithTransaction.RevokeCalendarFeeds(ctx, email); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to revoke calendar feeds: %w", err)
+	}
+
+	feedID, err := selfWithTransaction.CreateCalendarFeed(ctx, CreateCalendarFeedParams{
+		Email:     email,
+		TokenHash: tokenHash,
+	})
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to create calendar feed: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit RotateCalendarFeed: %w", err)
+	}
+
+	return feedID, nil
+}
+
+// NormalizedLinks reports what NormalizeLinkURLs changed.
+type NormalizedLinks struct {
+	// Updated counts the links whose url was rewritten.
+	Updated int
+	// Removed holds the links that were repeated in their trip once normalized.
+	Removed []Link
+	// Skipped holds the links whose url couldn't be normalized, which are kept as they are.
+	Skipped []Link
+}
+
+// NormalizeLinkURLs rewrites the url of every link with normalize and removes the links whose normalized url is already
+// used by another link of the same trip. Links have no creation time, so the one with the lowest ID is kept. Every link
+// is locked until the transaction ends, so links added in the meantime can't be missed.
+func (selfQueries *Queries) NormalizeLinkURLs(ctx context.Context, pool *pgxpool.Pool, normalize func(string) (string, error)) (NormalizedLinks, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return NormalizedLinks{}, fmt.Errorf("pgstore: failed to begin trx for NormalizeLinkURLs: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	links, err := selfWithTransaction.GetAllLinksForUpdate(ctx)
+	if err != nil {
+		return NormalizedLinks{}, fmt.Errorf("pgstore: failed to get links: %w", err)
+	}
+
+	type tripURL struct {
+		tripID uuid.UUID
+		url    string
+	}
+
+	var result NormalizedLinks
+	var updates []UpdateLinkURLParams
+	kept := make(map[tripURL]bool, len(links))
+	for _, link := range links {
+		normalized, err := normalize(link.Url)
+		if err != nil {
+			result.Skipped = append(result.Skipped, link)
+			normalized = link.Url
+		}
+
+		key := tripURL{tripID: link.TripID, url: normalized}
+		if kept[key] {
+			result.Removed = append(result.Removed, link)
+			continue
+		}
+		kept[key] = true
+
+		if normalized != link.Url {
+			updates = append(updates, UpdateLinkURLParams{Url: normalized, ID: link.ID})
+		}
+	}
+
+	// duplicates are removed first, so no url is ever saved twice in the same trip while the others are rewritten
+	for _, link := range result.Removed {
+		if _, err := selfWithTransaction.DeleteLink(ctx, DeleteLinkParams{ID: link.ID, TripID: link.TripID}); err != nil {
+			return NormalizedLinks{}, fmt.Errorf("pgstore: failed to delete duplicated link: %w", err)
+		}
+	}
+
+	for _, update := range updates {
+		if err := selfWithTransaction.UpdateLinkURL(ctx, update); err != nil {
+			return NormalizedLinks{}, fmt.Errorf("pgstore: failed to update link url: %w", err)
+		}
+	}
+	result.Updated = len(updates)
+
+	if err := tx.Commit(ctx); err != nil {
+		return NormalizedLinks{}, fmt.Errorf("pgstore: failed to commit NormalizeLinkURLs: %w", err)
+	}
+
+	return result, nil
+}
+
+// ImportTrip recreates a trip from a bundle, with new IDs, in a single transaction. The trip's status is kept, but the
+// participants are added back as pending, since nothing proves they ever answered the invitation, and are invited again
+// if the trip is already confirmed. Participants of draft trips are invited once the owner confirms it.
+func (selfQueries *Queries) ImportTrip(ctx context.Context, pool *pgxpool.Pool, bundle spec.TripBundle) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin trx for ImportTrip: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	baseCurrency := DefaultBaseCurrency
+	if bundle.Trip.BaseCurrency != nil {
+		baseCurrency = *bundle.Trip.BaseCurrency
+	}
+
+	tripID, err := selfWithTransaction.InsertImportedTrip(ctx, InsertImportedTripParams{
+		Destination:  bundle.Trip.Destination,
+		OwnerEmail:   string(bundle.Trip.OwnerEmail),
+		OwnerName:    bundle.Trip.OwnerName,
+		StartsAt:     pgtype.Timestamptz{Time: bundle.Trip.StartsAt, Valid: true},
+		EndsAt:       pgtype.Timestamptz{Time: bundle.Trip.EndsAt, Valid: true},
+		TimeZone:     bundle.Trip.TimeZone,
+		Status:       TripStatus(bundle.Trip.Status),
+		BaseCurrency: baseCurrency,
+	})
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip: %w", err)
+	}
+
+	for _, participant := range bundle.Participants {
+		participantID, err := selfWithTransaction.InviteParticipantToTrip(ctx, InviteParticipantToTripParams{
+			TripID: tripID,
+			Email:  string(participant.Email),
+		})
+		if err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert participant: %w", err)
+		}
+
+		if TripStatus(bundle.Trip.Status) == TripStatusConfirmed {
+			if err := selfWithTransaction.EnqueueMail(ctx, MailKindTripInvitation, MailPayload{
+				TripID:        tripID,
+				ParticipantID: participantID,
+			}); err != nil {
+				return uuid.UUID{}, err
+			}
+		}
+	}
+
+	for _, activity := range bundle.Activities {
+		if _, err := selfWithTransaction.CreateActivity(ctx, CreateActivityParams{
+			TripID:   tripID,
+			Title:    activity.Title,
+			OccursAt: pgtype.Timestamptz{Time: activity.OccursAt, Valid: true},
+		}); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert activity: %w", err)
+		}
+	}
+
+	for _, link := range bundle.Links {
+		if _, err := selfWithTransaction.CreateTripLink(ctx, CreateTripLinkParams{
+			TripID: tripID,
+			Title:  link.Title,
+			Url:    link.URL,
+		}); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert link: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit ImportTrip: %w", err)
+	}
+
+	return tripID, nil
+}
+
+// CloneTrip creates a new draft trip to the same destination as trip, starting at startsAt. Its end and activities are
+// moved by as many calendar days as its start, keeping their local time in the trip's time zone even across daylight
+// saving changes. The links are copied as they are and, if inviteParticipants is set, the participants are added back
+// as pending, to be invited once the new trip is confirmed.
+func (selfQueries *Queries) CloneTrip(ctx context.Context, pool *pgxpool.Pool, trip Trip, startsAt time.Time, inviteParticipants bool) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin trx for CloneTrip: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	location, err := time.LoadLocation(trip.TimeZone)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to load trip's time zone: %w", err)
+	}
+
+	days := calendarDaysBetween(trip.StartsAt.Time.In(location), startsAt.In(location))
+	endsAt := clonedTripEnd(trip, startsAt, location, days)
+
+	tripID, err := selfWithTransaction.InsertTrip(ctx, InsertTripParams{
+		Destination:  trip.Destination,
+		OwnerEmail:   trip.OwnerEmail,
+		OwnerName:    trip.OwnerName,
+		StartsAt:     pgtype.Timestamptz{Time: startsAt, Valid: true},
+		EndsAt:       pgtype.Timestamptz{Time: endsAt, Valid: true},
+		TimeZone:     trip.TimeZone,
+		BaseCurrency: trip.BaseCurrency,
+	})
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip: %w", err)
+	}
+
+	if err := selfWithTransaction.CopyTripActivities(ctx, CopyTripActivitiesParams{
+		NewTripID: tripID,
+		TimeZone:  trip.TimeZone,
+		Days:      int32(days),
+		TripID:    trip.ID,
+	}); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to copy trip's activities: %w", err)
+	}
+
+	if err := selfWithTransaction.CopyTripLinks(ctx, CopyTripLinksParams{
+		NewTripID: tripID,
+		TripID:    trip.ID,
+	}); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to copy trip's links: %w", err)
+	}
+
+	if inviteParticipants {
+		if err := selfWithTransaction.CopyTripParticipants(ctx, CopyTripParticipantsParams{
+			NewTripID: tripID,
+			TripID:    trip.ID,
+		}); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to copy trip's participants: %w", err)
+		}
+	}
+
+	// like a newly created trip, the clone has to be confirmed by its owner
+	if err := selfWithTransaction.EnqueueMail(ctx, MailKindConfirmTrip, MailPayload{TripID: tripID}); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit CloneTrip: %w", err)
+	}
+
+	return tripID, nil
+}
+
+// clonedTripEnd moves the end of trip by days calendar days in location, keeping its local time. When the clone starts
+// later in the day than trip did and would end before starting, it keeps the length of trip instead.
+func clonedTripEnd(trip Trip, startsAt time.Time, location *time.Location, days int) time.Time {
+	endsAt := trip.EndsAt.Time.In(location).AddDate(0, 0, days)
+	if !endsAt.After(startsAt) {
+		return startsAt.Add(trip.EndsAt.Time.Sub(trip.StartsAt.Time))
+	}
+	return endsAt
+}
+
+// calendarDaysBetween counts the calendar days from the date of from to the date of to, as seen in their locations.
+func calendarDaysBetween(from time.Time, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+// CreateTripTemplate creates a template owned by ownerEmail along with its activities and links.
+func (selfQueries *Queries) CreateTripTemplate(ctx context.Context, pool *pgxpool.Pool, ownerEmail string, template spec.TripTemplateInput) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin trx for CreateTripTemplate: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	templateID, err := selfWithTransaction.InsertTripTemplate(ctx, InsertTripTemplateParams{
+		OwnerEmail: ownerEmail,
+		Name:       template.Name,
+	})
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip template: %w", err)
+	}
+
+	if err := selfWithTransaction.insertTripTemplateItems(ctx, templateID, template); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit CreateTripTemplate: %w", err)
+	}
+
+	return templateID, nil
+}
+
+// ReplaceTripTemplate renames the template and replaces all of its activities and links.
+func (selfQueries *Queries) ReplaceTripTemplate(ctx context.Context, pool *pgxpool.Pool, templateID uuid.UUID, template spec.TripTemplateInput) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for ReplaceTripTemplate: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	if err := selfWithTransaction.UpdateTripTemplate(ctx, UpdateTripTemplateParams{
+		Name: template.Name,
+		ID:   templateID,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to update trip template: %w", err)
+	}
+
+	if err := selfWithTransaction.DeleteTripTemplateActivities(ctx, templateID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete trip template's activities: %w", err)
+	}
+
+	if err := selfWithTransaction.DeleteTripTemplateLinks(ctx, templateID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete trip template's links: %w", err)
+	}
+
+	if err := selfWithTransaction.insertTripTemplateItems(ctx, templateID, template); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit ReplaceTripTemplate: %w", err)
+	}
+
+	return nil
+}
+
+func (selfQueries *Queries) insertTripTemplateItems(ctx context.Context, templateID uuid.UUID, template spec.TripTemplateInput) error {
+	for _, activity := range template.Activities {
+		// times are validated as hh:mm before getting here
+		clock, err := time.Parse("15:04", activity.Time)
+		if err != nil {
+			return fmt.Errorf("pgstore: invalid template activity time %q: %w", activity.Time, err)
+		}
+
+		if err := selfQueries.InsertTripTemplateActivity(ctx, InsertTripTemplateActivityParams{
+			TemplateID: templateID,
+			Title:      activity.Title,
+			Day:        int32(activity.Day),
+			Time: pgtype.Time{
+				Microseconds: (time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute).Microseconds(),
+				Valid:        true,
+			},
+		}); err != nil {
+			return fmt.Errorf("pgstore: failed to insert trip template activity: %w", err)
+		}
+	}
+
+	for _, link := range template.Links {
+		if err := selfQueries.InsertTripTemplateLink(ctx, InsertTripTemplateLinkParams{
+			TemplateID: templateID,
+			Title:      link.Title,
+			Url:        link.URL,
+		}); err != nil {
+			return fmt.Errorf("pgstore: failed to insert trip template link: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// CreateExpense inserts the expense and the parts each participant owes of it. The expense ID and position of the
+// splits are filled in once the expense is inserted.
+func (selfQueries *Queries) CreateExpense(ctx context.Context, pool *pgxpool.Pool, params InsertExpenseParams, splits []InsertExpenseSplitParams) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin trx for CreateExpense: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	expenseID, err := selfWithTransaction.InsertExpense(ctx, params)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert expense: %w", err)
+	}
+
+	if err := selfWithTransaction.insertExpenseSplits(ctx, expenseID, splits); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit CreateExpense: %w", err)
+	}
+
+	return expenseID, nil
+}
+
+// ReplaceExpense updates the expense and replaces how it is split. It reports whether the expense was found in the
+// trip; when it wasn't, nothing is changed.
+func (selfQueries *Queries) ReplaceExpense(ctx context.Context, pool *pgxpool.Pool, params UpdateExpenseParams, splits []InsertExpenseSplitParams) (bool, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to begin trx for ReplaceExpense: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	updated, err := selfWithTransaction.UpdateExpense(ctx, params)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to update expense: %w", err)
+	}
+
+	if updated == 0 {
+		return false, nil
+	}
+
+	if err := selfWithTransaction.DeleteExpenseSplits(ctx, params.ID); err != nil {
+		return false, fmt.Errorf("pgstore: failed to delete expense's splits: %w", err)
+	}
+
+	if err := selfWithTransaction.insertExpenseSplits(ctx, params.ID, splits); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("pgstore: failed to commit ReplaceExpense: %w", err)
+	}
+
+	return true, nil
+}
+
+func (selfQueries *Queries) insertExpenseSplits(ctx context.Context, expenseID uuid.UUID, splits []InsertExpenseSplitParams) error {
+	for i, split := range splits {
+		split.ExpenseID = expenseID
+		split.Position = int32(i)
+		if err := selfQueries.InsertExpenseSplit(ctx, split); err != nil {
+			return fmt.Errorf("pgstore: failed to insert expense split: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ImportExchangeRates saves every rate at once, so a file with a bad line doesn't leave half of its rates loaded.
+func (selfQueries *Queries) ImportExchangeRates(ctx context.Context, pool *pgxpool.Pool, rates []UpsertExchangeRateParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for ImportExchangeRates: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	for _, rate := range rates {
+		if err := selfWithTransaction.UpsertExchangeRate(ctx, rate); err != nil {
+			return fmt.Errorf("pgstore: failed to save %s/%s exchange rate: %w", rate.BaseCurrency, rate.QuoteCurrency, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit ImportExchangeRates: %w", err)
+	}
+
+	return nil
+}
+
+// ReplaceTripBudgets replaces every budget of the trip with the given ones.
+func (selfQueries *Queries) ReplaceTripBudgets(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, budgets []InsertTripBudgetParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for ReplaceTripBudgets: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	if err := selfWithTransaction.DeleteTripBudgets(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete trip's budgets: %w", err)
+	}
+
+	for _, budget := range budgets {
+		budget.TripID = tripID
+		if err := selfWithTransaction.InsertTripBudget(ctx, budget); err != nil {
+			return fmt.Errorf("pgstore: failed to insert trip budget: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit ReplaceTripBudgets: %w", err)
+	}
+
+	return nil
+}
+
+// ReorderChecklistItems moves the checklist's items to the position they have in itemIDs.
+func (selfQueries *Queries) ReorderChecklistItems(ctx context.Context, pool *pgxpool.Pool, checklistID uuid.UUID, itemIDs []uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for ReorderChecklistItems: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	for position, itemID := range itemIDs {
+		if err := selfWithTransaction.SetChecklistItemPosition(ctx, SetChecklistItemPositionParams{
+			Position:    int32(position),
+			ID:          itemID,
+			ChecklistID: checklistID,
+		}); err != nil {
+			return fmt.Errorf("pgstore: failed to move checklist item: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit ReorderChecklistItems: %w", err)
+	}
+
+	return nil
+}
+
+// CreateActivityProposal creates the proposal and, when it is a poll, its options in the given order.
+func (selfQueries *Queries) CreateActivityProposal(ctx context.Context, pool *pgxpool.Pool, proposal InsertActivityProposalParams, options []InsertActivityProposalOptionParams) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin trx for CreateActivityProposal: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	proposalID, err := selfWithTransaction.InsertActivityProposal(ctx, proposal)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert activity proposal: %w", err)
+	}
+
+	for position, option := range options {
+		option.ProposalID = proposalID
+		option.Position = int32(position)
+		if err := selfWithTransaction.InsertActivityProposalOption(ctx, option); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert activity proposal option: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit CreateActivityProposal: %w", err)
+	}
+
+	return proposalID, nil
+}
+
+// PromoteActivityProposal schedules the activity and marks the proposal as promoted to it. It reports false, without
+// scheduling anything, when the proposal was already promoted.
+func (selfQueries *Queries) PromoteActivityProposal(ctx context.Context, pool *pgxpool.Pool, proposalID uuid.UUID, activity CreateActivityParams) (uuid.UUID, bool, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, false, fmt.Errorf("pgstore: failed to begin trx for PromoteActivityProposal: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	selfWithTransaction := selfQueries.WithTx(tx)
+
+	activityID, err := selfWithTransaction.CreateActivity(ctx, activity)
+	if err != nil {
+		return uuid.UUID{}, false, fmt.Errorf("pgstore: failed to create activity: %w", err)
+	}
+
+	promoted, err := selfWithTransaction.MarkActivityProposalAsPromoted(ctx, MarkActivityProposalAsPromotedParams{
+		ActivityID: pgtype.UUID{Bytes: activityID, Valid: true},
+		ID:         proposalID,
+	})
+	if err != nil {
+		return uuid.UUID{}, false, fmt.Errorf("pgstore: failed to mark activity proposal as promoted: %w", err)
+	}
+
+	if promoted == 0 {
+		return uuid.UUID{}, false, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, false, fmt.Errorf("pgstore: failed to commit PromoteActivityProposal: %w", err)
+	}
+
+	return activityID, true, nil
+}
@@ -0,0 +1,86 @@
+package pgstore
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"testing"
+	"time"
+)
+
+func TestCalendarDaysBetween(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want int
+	}{
+		{name: "same day", from: time.Date(2024, 3, 1, 8, 0, 0, 0, location), to: time.Date(2024, 3, 1, 23, 0, 0, 0, location), want: 0},
+		{name: "late to early", from: time.Date(2024, 3, 1, 23, 0, 0, 0, location), to: time.Date(2024, 3, 2, 1, 0, 0, 0, location), want: 1},
+		{name: "across daylight saving", from: time.Date(2024, 3, 9, 9, 0, 0, 0, location), to: time.Date(2024, 3, 16, 9, 0, 0, 0, location), want: 7},
+		{name: "backwards", from: time.Date(2024, 11, 10, 9, 0, 0, 0, location), to: time.Date(2024, 11, 1, 9, 0, 0, 0, location), want: -9},
+		{name: "across years", from: time.Date(2023, 12, 31, 9, 0, 0, 0, location), to: time.Date(2024, 12, 31, 9, 0, 0, 0, location), want: 366},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := calendarDaysBetween(test.from, test.to); got != test.want {
+				t.Errorf("calendarDaysBetween(%s, %s) = %d, want %d", test.from, test.to, got, test.want)
+			}
+		})
+	}
+}
+
+func TestClonedTripEnd(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	// the trip starts before daylight saving begins on March 10 and ends after it
+	trip := Trip{
+		StartsAt: pgtype.Timestamptz{Time: time.Date(2024, 3, 8, 9, 0, 0, 0, location), Valid: true},
+		EndsAt:   pgtype.Timestamptz{Time: time.Date(2024, 3, 11, 18, 0, 0, 0, location), Valid: true},
+	}
+
+	tests := []struct {
+		name     string
+		startsAt time.Time
+		want     time.Time
+	}{
+		{
+			name:     "keeps the local time across daylight saving",
+			startsAt: time.Date(2024, 3, 15, 9, 0, 0, 0, location),
+			want:     time.Date(2024, 3, 18, 18, 0, 0, 0, location),
+		},
+		{
+			name:     "keeps the local time when the clone starts at another hour",
+			startsAt: time.Date(2024, 3, 15, 14, 0, 0, 0, location),
+			want:     time.Date(2024, 3, 18, 18, 0, 0, 0, location),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			days := calendarDaysBetween(trip.StartsAt.Time.In(location), test.startsAt)
+			if got := clonedTripEnd(trip, test.startsAt, location, days); !got.Equal(test.want) {
+				t.Errorf("clonedTripEnd(%s) = %s, want %s", test.startsAt, got, test.want)
+			}
+		})
+	}
+
+	sameDay := Trip{
+		StartsAt: pgtype.Timestamptz{Time: time.Date(2024, 3, 8, 9, 0, 0, 0, location), Valid: true},
+		EndsAt:   pgtype.Timestamptz{Time: time.Date(2024, 3, 8, 12, 0, 0, 0, location), Valid: true},
+	}
+	startsAt := time.Date(2024, 3, 15, 13, 0, 0, 0, location)
+	want := time.Date(2024, 3, 15, 16, 0, 0, 0, location)
+
+	days := calendarDaysBetween(sameDay.StartsAt.Time.In(location), startsAt)
+	if got := clonedTripEnd(sameDay, startsAt, location, days); !got.Equal(want) {
+		t.Errorf("clonedTripEnd(%s) of a trip ending before the clone starts = %s, want %s", startsAt, got, want)
+	}
+}
@@ -0,0 +1,81 @@
+package token
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	signer := NewSigner([]byte("segredo"))
+
+	sign := func(purpose string, ttl time.Duration) string {
+		t.Helper()
+		token, err := signer.Sign(purpose, "viajante@example.com", ttl)
+		if err != nil {
+			t.Fatalf("Sign() error = %v", err)
+		}
+		return token
+	}
+
+	valid := sign(PurposeLogin, time.Hour)
+	payload, signature, _ := strings.Cut(valid, ".")
+
+	decoded, _ := base64.RawURLEncoding.DecodeString(payload)
+	tamperedPayload := base64.RawURLEncoding.EncodeToString([]byte(strings.Replace(string(decoded), "viajante", "intruso", 1)))
+
+	tamperedSignature := []byte(signature)
+	if tamperedSignature[0] == 'A' {
+		tamperedSignature[0] = 'B'
+	} else {
+		tamperedSignature[0] = 'A'
+	}
+
+	otherSecret, err := NewSigner([]byte("outro segredo")).Sign(PurposeLogin, "viajante@example.com", time.Hour)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		purpose string
+		wantErr error
+	}{
+		{name: "round trip", token: valid, purpose: PurposeLogin},
+		{name: "tampered payload", token: tamperedPayload + "." + signature, purpose: PurposeLogin, wantErr: ErrInvalid},
+		{name: "tampered signature", token: payload + "." + string(tamperedSignature), purpose: PurposeLogin, wantErr: ErrInvalid},
+		{name: "wrong purpose", token: valid, purpose: PurposeSession, wantErr: ErrInvalid},
+		{name: "expired", token: sign(PurposeLogin, -time.Minute), purpose: PurposeLogin, wantErr: ErrExpired},
+		{name: "no separator", token: payload + signature, purpose: PurposeLogin, wantErr: ErrInvalid},
+		{name: "bad base64 signature", token: payload + ".!!!", purpose: PurposeLogin, wantErr: ErrInvalid},
+		{
+			name:    "bad base64 payload",
+			token:   "!!!." + base64.RawURLEncoding.EncodeToString(signer.sign("!!!")),
+			purpose: PurposeLogin,
+			wantErr: ErrInvalid,
+		},
+		{name: "signed with another secret", token: otherSecret, purpose: PurposeLogin, wantErr: ErrInvalid},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			claims, err := signer.Verify(test.token, test.purpose)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if claims.Subject != "viajante@example.com" || claims.Purpose != PurposeLogin {
+				t.Errorf("Verify() = %+v, want the signed subject and purpose", claims)
+			}
+			if until := time.Until(claims.ExpirationTime()); until <= 59*time.Minute || until > time.Hour {
+				t.Errorf("Verify() expires in %s, want about an hour", until)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+// Package unfurl fetches the metadata shown in link previews: the page title and description, its OpenGraph image and
+// its favicon.
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultTimeout bounds the whole fetch, redirects and body included.
+	DefaultTimeout = 5 * time.Second
+	// DefaultMaxBytes is how much of the page is read. Metadata lives in the page's head, so there is no need to read
+	// further.
+	DefaultMaxBytes = 512 << 10
+
+	maxRedirects       = 5
+	maxTitleLength     = 300
+	maxDescriptionSize = 1000
+	maxURLLength       = 2048
+)
+
+// ErrPrivateAddress is returned when the link, or one of its redirects, points to a loopback, private or otherwise
+// internal address, which must never be reachable through user-provided URLs.
+var ErrPrivateAddress = errors.New("unfurl: refusing to connect to a private address")
+
+// Preview is the metadata of a page. Fields the page doesn't provide are left empty.
+type Preview struct {
+	Title       string
+	Description string
+	ImageURL    string
+	FaviconURL  string
+}
+
+// Fetcher fetches the preview of a link.
+type Fetcher interface {
+	Fetch(ctx context.Context, link string) (Preview, error)
+}
+
+// Config tunes an HTTPFetcher. Zero values fall back to the defaults.
+type Config struct {
+	Timeout  time.Duration
+	MaxBytes int64
+
+	// AllowPrivateAddresses lifts the private address check. It is only meant for tests running against a local
+	// server.
+	AllowPrivateAddresses bool
+}
+
+// HTTPFetcher fetches previews over HTTP, reading the page's HTML.
+type HTTPFetcher struct {
+	client   *http.Client
+	maxBytes int64
+}
+
+func NewHTTPFetcher(config Config) HTTPFetcher {
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+	if config.MaxBytes <= 0 {
+		config.MaxBytes = DefaultMaxBytes
+	}
+
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	if !config.AllowPrivateAddresses {
+		// the check runs on the resolved address right before connecting, so neither redirects nor DNS records
+		// pointing to internal hosts get through
+		dialer.Control = func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			ip := net.ParseIP(host)
+			if ip == nil || isPrivateIP(ip) {
+				return ErrPrivateAddress
+			}
+
+			return nil
+		}
+	}
+
+	return HTTPFetcher{
+		client: &http.Client{
+			Timeout: config.Timeout,
+			Transport: &http.Transport{
+				// a proxy would make the connection on our behalf, skipping the private address check
+				Proxy:                 nil,
+				DialContext:           dialer.DialContext,
+				TLSHandshakeTimeout:   config.Timeout,
+				ResponseHeaderTimeout: config.Timeout,
+				MaxIdleConns:          10,
+				IdleConnTimeout:       time.Minute,
+			},
+			CheckRedirect: func(request *http.Request, via []*http.Request) error {
+				// via holds every request made so far, the first one included
+				if len(via) > maxRedirects {
+					return errors.New("unfurl: too many redirects")
+				}
+				if !isWebURL(request.URL) {
+					return fmt.Errorf("unfurl: refusing to follow redirect to %q", request.URL.Scheme)
+				}
+				return nil
+			},
+		},
+		maxBytes: config.MaxBytes,
+	}
+}
+
+// Fetch downloads the page and reads its metadata. Pages that aren't HTML, such as images or PDFs, have no metadata
+// and give an empty preview.
+func (fetcher HTTPFetcher) Fetch(ctx context.Context, link string) (Preview, error) {
+	pageURL, err := url.Parse(link)
+	if err != nil {
+		return Preview{}, fmt.Errorf("unfurl: invalid url: %w", err)
+	}
+	if !isWebURL(pageURL) {
+		return Preview{}, fmt.Errorf("unfurl: unsupported scheme %q", pageURL.Scheme)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL.String(), nil)
+	if err != nil {
+		return Preview{}, fmt.Errorf("unfurl: failed to create request: %w", err)
+	}
+	request.Header.Set("Accept", "text/html,application/xhtml+xml")
+	request.Header.Set("User-Agent", "plann.er link preview")
+
+	response, err := fetcher.client.Do(request)
+	if err != nil {
+		if errors.Is(err, ErrPrivateAddress) {
+			return Preview{}, ErrPrivateAddress
+		}
+		return Preview{}, fmt.Errorf("unfurl: failed to fetch page: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return Preview{}, fmt.Errorf("unfurl: unexpected status %d", response.StatusCode)
+	}
+
+	contentType := response.Header.Get("Content-Type")
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return Preview{}, nil
+	}
+
+	body, err := charset.NewReader(io.LimitReader(response.Body, fetcher.maxBytes), contentType)
+	if err != nil {
+		return Preview{}, fmt.Errorf("unfurl: failed to decode page: %w", err)
+	}
+
+	// relative URLs are resolved against the page the redirects ended at
+	return parse(body, response.Request.URL), nil
+}
+
+// parse reads the metadata in the page's head. It stops at the body, or wherever the reader ends, so a truncated page
+// still gives whatever was read up to that point.
+func parse(body io.Reader, pageURL *url.URL) Preview {
+	// OpenGraph tags are preferred, then Twitter cards, then the page's own title, description and icons
+	var preview, fallback Preview
+	var title strings.Builder
+	var inTitle bool
+
+	done := func() Preview {
+		if fallback.Title == "" {
+			fallback.Title = title.String()
+		}
+		return merge(preview, fallback, pageURL)
+	}
+
+	tokenizer := html.NewTokenizer(body)
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return done()
+
+		case html.TextToken:
+			if inTitle {
+				title.Write(tokenizer.Text())
+			}
+
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			switch string(name) {
+			case "title":
+				inTitle = false
+			case "head":
+				return done()
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttributes := tokenizer.TagName()
+			attributes := map[string]string{}
+			for hasAttributes {
+				var key, value []byte
+				key, value, hasAttributes = tokenizer.TagAttr()
+				attributes[string(key)] = string(value)
+			}
+
+			switch string(name) {
+			case "body":
+				return done()
+
+			case "title":
+				// only the first title counts, since SVGs in the head may have their own
+				inTitle = title.Len() == 0
+
+			case "meta":
+				content := attributes["content"]
+				switch strings.ToLower(attributes["property"] + attributes["name"]) {
+				case "og:title":
+					preview.Title = content
+				case "og:description":
+					preview.Description = content
+				case "og:image", "og:image:url", "og:image:secure_url":
+					if preview.ImageURL == "" {
+						preview.ImageURL = content
+					}
+				case "twitter:title":
+					fallback.Title = content
+				case "twitter:description":
+					fallback.Description = content
+				case "description":
+					if fallback.Description == "" {
+						fallback.Description = content
+					}
+				case "twitter:image":
+					fallback.ImageURL = content
+				}
+
+			case "link":
+				for _, rel := range strings.Fields(strings.ToLower(attributes["rel"])) {
+					if rel == "icon" && preview.FaviconURL == "" {
+						preview.FaviconURL = attributes["href"]
+					}
+					if rel == "apple-touch-icon" {
+						fallback.FaviconURL = attributes["href"]
+					}
+				}
+			}
+		}
+	}
+}
+
+// merge fills what OpenGraph didn't provide with the page's own metadata, and cleans every field up.
+func merge(preview Preview, fallback Preview, pageURL *url.URL) Preview {
+	if preview.Title == "" {
+		preview.Title = fallback.Title
+	}
+	if preview.Description == "" {
+		preview.Description = fallback.Description
+	}
+	if preview.ImageURL == "" {
+		preview.ImageURL = fallback.ImageURL
+	}
+	if preview.FaviconURL == "" {
+		preview.FaviconURL = fallback.FaviconURL
+	}
+	if preview.FaviconURL == "" {
+		// browsers look for it there when the page doesn't declare one
+		preview.FaviconURL = "/favicon.ico"
+	}
+
+	return Preview{
+		Title:       truncate(collapseSpaces(preview.Title), maxTitleLength),
+		Description: truncate(collapseSpaces(preview.Description), maxDescriptionSize),
+		ImageURL:    resolve(pageURL, preview.ImageURL),
+		FaviconURL:  resolve(pageURL, preview.FaviconURL),
+	}
+}
+
+// resolve makes reference absolute. References that aren't web URLs, such as data: URIs, are dropped.
+func resolve(pageURL *url.URL, reference string) string {
+	reference = strings.TrimSpace(reference)
+	if reference == "" {
+		return ""
+	}
+
+	parsed, err := url.Parse(reference)
+	if err != nil {
+		return ""
+	}
+
+	resolved := pageURL.ResolveReference(parsed)
+	if !isWebURL(resolved) || len(resolved.String()) > maxURLLength {
+		return ""
+	}
+
+	return resolved.String()
+}
+
+func isWebURL(link *url.URL) bool {
+	return (link.Scheme == "http" || link.Scheme == "https") && link.Host != ""
+}
+
+func collapseSpaces(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// truncate cuts value to at most limit characters, never splitting one in half.
+func truncate(value string, limit int) string {
+	if utf8.RuneCountInString(value) <= limit {
+		return value
+	}
+
+	runes := []rune(value)
+	return strings.TrimSpace(string(runes[:limit-1])) + "…"
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which net.IP.IsPrivate doesn't cover.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsPrivate() ||
+		ip.IsLoopback() ||
+		ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		sharedAddressSpace.Contains(ip)
+}
@@ -0,0 +1,180 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/page", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, `<html><head>
+			<title>Página da viagem</title>
+			<meta property="og:description" content="Roteiro   completo">
+			<meta property="og:image" content="/capa.png">
+		</head><body></body></html>`)
+	})
+	mux.HandleFunc("/hop/", func(w http.ResponseWriter, r *http.Request) {
+		left, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/hop/"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		if left == 0 {
+			http.Redirect(w, r, "/page", http.StatusFound)
+			return
+		}
+		http.Redirect(w, r, fmt.Sprintf("/hop/%d", left-1), http.StatusFound)
+	})
+	mux.HandleFunc("/ftp", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "ftp://example.com/roteiro.pdf", http.StatusFound)
+	})
+	mux.HandleFunc("/long", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, `<html><head><title>Antes</title><!-- %s --><meta property="og:title" content="Depois"></head></html>`,
+			strings.Repeat("x", 4096))
+	})
+	mux.HandleFunc("/image", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprint(w, "\x89PNG")
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFetch(t *testing.T) {
+	server := newTestServer(t)
+	fetcher := NewHTTPFetcher(Config{AllowPrivateAddresses: true})
+
+	got, err := fetcher.Fetch(context.Background(), server.URL+"/hop/1")
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+
+	want := Preview{
+		Title:       "Página da viagem",
+		Description: "Roteiro completo",
+		ImageURL:    server.URL + "/capa.png",
+		FaviconURL:  server.URL + "/favicon.ico",
+	}
+	if got != want {
+		t.Errorf("Fetch() = %+v, want %+v", got, want)
+	}
+}
+
+func TestFetchSkipsPagesThatArentHTML(t *testing.T) {
+	server := newTestServer(t)
+	fetcher := NewHTTPFetcher(Config{AllowPrivateAddresses: true})
+
+	got, err := fetcher.Fetch(context.Background(), server.URL+"/image")
+	if err != nil || got != (Preview{}) {
+		t.Errorf("Fetch() = %+v, %v, want an empty preview", got, err)
+	}
+}
+
+func TestFetchRejectsPrivateAddresses(t *testing.T) {
+	server := newTestServer(t)
+	fetcher := NewHTTPFetcher(Config{})
+
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	for _, link := range []string{server.URL + "/page", "http://localhost:" + port + "/page"} {
+		if _, err := fetcher.Fetch(context.Background(), link); !errors.Is(err, ErrPrivateAddress) {
+			t.Errorf("Fetch(%s) error = %v, want %v", link, err, ErrPrivateAddress)
+		}
+	}
+}
+
+func TestFetchLimitsRedirects(t *testing.T) {
+	server := newTestServer(t)
+	fetcher := NewHTTPFetcher(Config{AllowPrivateAddresses: true})
+
+	// hop 0 redirects to the page as well, so /hop/n takes n+1 redirects
+	if _, err := fetcher.Fetch(context.Background(), fmt.Sprintf("%s/hop/%d", server.URL, maxRedirects-1)); err != nil {
+		t.Errorf("Fetch() with %d redirects error = %v", maxRedirects, err)
+	}
+
+	_, err := fetcher.Fetch(context.Background(), fmt.Sprintf("%s/hop/%d", server.URL, maxRedirects))
+	if err == nil || !strings.Contains(err.Error(), "too many redirects") {
+		t.Errorf("Fetch() with %d redirects error = %v, want too many redirects", maxRedirects+1, err)
+	}
+}
+
+func TestFetchRejectsRedirectsToOtherSchemes(t *testing.T) {
+	server := newTestServer(t)
+	fetcher := NewHTTPFetcher(Config{AllowPrivateAddresses: true})
+
+	_, err := fetcher.Fetch(context.Background(), server.URL+"/ftp")
+	if err == nil || !strings.Contains(err.Error(), "refusing to follow redirect") {
+		t.Errorf("Fetch() error = %v, want the redirect to be refused", err)
+	}
+}
+
+func TestFetchRejectsOtherSchemes(t *testing.T) {
+	fetcher := NewHTTPFetcher(Config{AllowPrivateAddresses: true})
+
+	for _, link := range []string{"ftp://example.com/roteiro.pdf", "javascript:alert(1)", "file:///etc/passwd"} {
+		if _, err := fetcher.Fetch(context.Background(), link); err == nil {
+			t.Errorf("Fetch(%s) error = nil, want the scheme to be refused", link)
+		}
+	}
+}
+
+func TestFetchReadsUpToMaxBytes(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		maxBytes int64
+		want     string
+	}{
+		{maxBytes: 1024, want: "Antes"},
+		{maxBytes: 0, want: "Depois"},
+	}
+
+	for _, test := range tests {
+		fetcher := NewHTTPFetcher(Config{AllowPrivateAddresses: true, MaxBytes: test.maxBytes})
+
+		got, err := fetcher.Fetch(context.Background(), server.URL+"/long")
+		if err != nil {
+			t.Fatalf("Fetch() error = %v", err)
+		}
+		if got.Title != test.want {
+			t.Errorf("Fetch() reading %d bytes got title %q, want %q", test.maxBytes, got.Title, test.want)
+		}
+	}
+}
+
+func TestIsPrivateIP(t *testing.T) {
+	tests := map[string]bool{
+		"127.0.0.1":       true,
+		"10.1.2.3":        true,
+		"172.16.0.1":      true,
+		"192.168.0.1":     true,
+		"100.64.0.1":      true,
+		"169.254.169.254": true,
+		"0.0.0.0":         true,
+		"::1":             true,
+		"fd00::1":         true,
+		"fe80::1":         true,
+		"8.8.8.8":         false,
+		"100.128.0.1":     false,
+		"2001:4860::8888": false,
+	}
+
+	for address, want := range tests {
+		if got := isPrivateIP(net.ParseIP(address)); got != want {
+			t.Errorf("isPrivateIP(%s) = %t, want %t", address, got, want)
+		}
+	}
+}